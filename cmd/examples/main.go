@@ -8,6 +8,7 @@ import (
 
 	e "github.com/kpse/go-cat/pkg/monad/either"
 	m "github.com/kpse/go-cat/pkg/monad/maybe"
+	r "github.com/kpse/go-cat/pkg/monad/result"
 )
 
 func safeDivide(x int) m.Maybe[int] {
@@ -40,6 +41,22 @@ func main() {
 	maybeExamples()
 
 	eitherExamples()
+
+	resultExamples()
+}
+
+func resultExamples() {
+	fmt.Println("\n=== Result Example ===")
+	parsed := r.FromPair(strconv.Atoi("21"))
+	doubled := r.Map(parsed, double)
+	if v, err := doubled.ToPair(); err == nil {
+		fmt.Printf("21 * 2 = %d\n", v)
+	}
+
+	failed := r.FromPair(strconv.Atoi("not a number"))
+	if err := failed.Err(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
 }
 
 func eitherExamples() {
@@ -72,15 +89,15 @@ func eitherExamples() {
 		fmt.Printf("Error: %s\n", badChain.GetLeft())
 	}
 
-	fmt.Println("\n=== FromNillable Example ===")
+	fmt.Println("\n=== FromPtr Example ===")
 	var ptr *int
-	nilResult := e.FromNillable(ptr, "nil pointer error")
+	nilResult := e.FromPtr(ptr, "nil pointer error")
 	if nilResult.IsLeft() {
 		fmt.Printf("Error: %s\n", nilResult.GetLeft())
 	}
 
 	value := 42
-	ptrResult := e.FromNillable(&value, "nil pointer error")
+	ptrResult := e.FromPtr(&value, "nil pointer error")
 	if ptrResult.IsRight() {
 		fmt.Printf("Value: %d\n", ptrResult.GetRight())
 	}
@@ -0,0 +1,104 @@
+package base
+
+import "errors"
+
+// Semigroup represents a type with an associative binary operation
+type Semigroup[T any] interface {
+	Combine(x, y T) T
+}
+
+// Monoid represents a Semigroup with an identity element
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+// SemigroupFunc adapts an associative function to a Semigroup
+type SemigroupFunc[T any] func(x, y T) T
+
+// Combine calls f(x, y)
+func (f SemigroupFunc[T]) Combine(x, y T) T {
+	return f(x, y)
+}
+
+type monoid[T any] struct {
+	SemigroupFunc[T]
+	empty T
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+// NewMonoid creates a Monoid from an identity element and a combine function
+func NewMonoid[T any](empty T, combine func(x, y T) T) Monoid[T] {
+	return monoid[T]{SemigroupFunc: combine, empty: empty}
+}
+
+// SliceMonoid concatenates slices into a fresh slice, never aliasing its inputs
+func SliceMonoid[T any]() Monoid[[]T] {
+	return NewMonoid(nil, func(x, y []T) []T {
+		if len(x) == 0 && len(y) == 0 {
+			return nil
+		}
+		out := make([]T, 0, len(x)+len(y))
+		return append(append(out, x...), y...)
+	})
+}
+
+// StringMonoid concatenates strings
+func StringMonoid() Monoid[string] {
+	return NewMonoid("", func(x, y string) string { return x + y })
+}
+
+// ErrorMonoid combines errors with errors.Join; nil is the identity
+func ErrorMonoid() Monoid[error] {
+	return NewMonoid(nil, func(x, y error) error {
+		if x == nil {
+			return y
+		}
+		if y == nil {
+			return x
+		}
+		return errors.Join(x, y)
+	})
+}
+
+// ConcatAll folds values left to right with the monoid, starting from Empty
+func ConcatAll[T any](m Monoid[T], xs ...T) T {
+	acc := m.Empty()
+	for _, x := range xs {
+		acc = m.Combine(acc, x)
+	}
+	return acc
+}
+
+// Number is the set of types SumMonoid can add
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// SumMonoid adds numbers; 0 is the identity
+func SumMonoid[N Number]() Monoid[N] {
+	return NewMonoid(N(0), func(x, y N) N { return x + y })
+}
+
+// MapMonoid merges maps into a fresh map, combining values stored under the
+// same key with values
+func MapMonoid[K comparable, V any](values Semigroup[V]) Monoid[map[K]V] {
+	return NewMonoid(nil, func(x, y map[K]V) map[K]V {
+		out := make(map[K]V, len(x)+len(y))
+		for k, v := range x {
+			out[k] = v
+		}
+		for k, v := range y {
+			if existing, ok := out[k]; ok {
+				v = values.Combine(existing, v)
+			}
+			out[k] = v
+		}
+		return out
+	})
+}
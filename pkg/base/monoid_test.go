@@ -0,0 +1,64 @@
+package base_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMonoid_Instances tests the built-in monoid instances
+func TestMonoid_Instances(t *testing.T) {
+	t.Run("slices concatenate without aliasing", func(t *testing.T) {
+		m := base.SliceMonoid[int]()
+		x := make([]int, 1, 10)
+		x[0] = 1
+		combined := m.Combine(x, []int{2, 3})
+		assert.Equal(t, []int{1, 2, 3}, combined)
+
+		combined[0] = 99
+		assert.Equal(t, 1, x[0])
+		assert.Nil(t, m.Empty())
+	})
+
+	t.Run("strings concatenate", func(t *testing.T) {
+		assert.Equal(t, "abc", base.ConcatAll(base.StringMonoid(), "a", "b", "c"))
+	})
+
+	t.Run("errors join and nil is the identity", func(t *testing.T) {
+		m := base.ErrorMonoid()
+		errA, errB := errors.New("a"), errors.New("b")
+
+		assert.Same(t, errA, m.Combine(nil, errA))
+		assert.Same(t, errA, m.Combine(errA, nil))
+
+		joined := m.Combine(errA, errB)
+		assert.ErrorIs(t, joined, errA)
+		assert.ErrorIs(t, joined, errB)
+		assert.Nil(t, base.ConcatAll(m))
+	})
+
+	t.Run("numbers sum", func(t *testing.T) {
+		assert.Equal(t, 6, base.ConcatAll(base.SumMonoid[int](), 1, 2, 3))
+		assert.Equal(t, 1.5, base.ConcatAll(base.SumMonoid[float64](), 0.5, 1))
+	})
+
+	t.Run("maps merge values by key", func(t *testing.T) {
+		m := base.MapMonoid[string](base.SumMonoid[int]())
+		x := map[string]int{"a": 1, "b": 2}
+		merged := m.Combine(x, map[string]int{"b": 3, "c": 4})
+
+		assert.Equal(t, map[string]int{"a": 1, "b": 5, "c": 4}, merged)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, x)
+	})
+}
+
+// TestMonoid_Laws tests associativity and identity for a custom monoid
+func TestMonoid_Laws(t *testing.T) {
+	sum := base.NewMonoid(0, func(x, y int) int { return x + y })
+
+	assert.Equal(t, sum.Combine(sum.Combine(1, 2), 3), sum.Combine(1, sum.Combine(2, 3)))
+	assert.Equal(t, 5, sum.Combine(sum.Empty(), 5))
+	assert.Equal(t, 5, sum.Combine(5, sum.Empty()))
+}
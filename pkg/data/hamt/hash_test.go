@@ -0,0 +1,50 @@
+package hamt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+	_    int
+	Tag  string
+}
+
+func TestHashOf(t *testing.T) {
+	t.Run("equal keys hash alike", func(t *testing.T) {
+		shared := new(int)
+		assert.Equal(t, hashOf("go"), hashOf("go"))
+		assert.Equal(t, hashOf(int8(-3)), hashOf(int8(-3)))
+		assert.Equal(t, hashOf(point{X: 1, Y: 2, Tag: "a"}), hashOf(point{X: 1, Y: 2, Tag: "a"}))
+		assert.Equal(t, hashOf([2]string{"a", "b"}), hashOf([2]string{"a", "b"}))
+		assert.Equal(t, hashOf(shared), hashOf(shared))
+		assert.Equal(t, hashOf(math.Copysign(0, -1)), hashOf(0.0))
+		assert.Equal(t, hashOf(any(point{X: 1})), hashOf(any(point{X: 1})))
+		assert.Equal(t, hashOf[any](nil), hashOf[any](nil))
+	})
+
+	t.Run("different keys usually hash apart", func(t *testing.T) {
+		assert.NotEqual(t, hashOf(point{X: 1, Y: 2}), hashOf(point{X: 2, Y: 1}))
+		assert.NotEqual(t, hashOf([2]string{"ab", ""}), hashOf([2]string{"a", "b"}))
+		assert.NotEqual(t, hashOf(new(int)), hashOf(new(int)))
+		assert.NotEqual(t, hashOf[any](1), hashOf[any]("1"))
+	})
+
+	t.Run("unhashable interface values panic like ==", func(t *testing.T) {
+		assert.Panics(t, func() { hashOf[any]([]int{1}) })
+	})
+
+	t.Run("Map with struct and interface keys", func(t *testing.T) {
+		m := Empty[point, string]().Set(point{X: 1, Y: 2}, "a").Set(point{X: 2, Y: 1}, "b")
+		assert.Equal(t, "a", m.Get(point{X: 1, Y: 2}).Get())
+		assert.Equal(t, "b", m.Get(point{X: 2, Y: 1}).Get())
+
+		mixed := Empty[any, int]().Set(1, 1).Set("1", 2).Set(point{}, 3)
+		assert.Equal(t, 3, mixed.Len())
+		assert.Equal(t, 2, mixed.Get("1").Get())
+		assert.Equal(t, 3, mixed.Get(point{}).Get())
+	})
+}
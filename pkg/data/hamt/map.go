@@ -0,0 +1,190 @@
+// Package hamt provides persistent Map and Set types backed by a hash array
+// mapped trie.
+//
+// Every update returns a new version in O(log32 n) and shares unchanged
+// subtrees with the old one, so a version can be handed to readers as a
+// snapshot without locking. A Builder batches many updates, modifying its
+// own nodes in place until the result is frozen with Map.
+package hamt
+
+import (
+	"iter"
+
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+// Map is an immutable hash map. The zero value is an empty Map.
+type Map[K comparable, V any] struct {
+	root *node[K, V]
+	size int
+}
+
+// Empty returns an empty Map
+func Empty[K comparable, V any]() Map[K, V] {
+	return Map[K, V]{}
+}
+
+// FromMap creates a Map holding the entries of a Go map
+func FromMap[K comparable, V any](m map[K]V) Map[K, V] {
+	b := NewBuilder[K, V]()
+	for k, v := range m {
+		b.Set(k, v)
+	}
+	return b.Map()
+}
+
+// Len returns the number of entries
+func (m Map[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns the value stored under key, or Nothing
+func (m Map[K, V]) Get(key K) maybe.Maybe[V] {
+	if m.root == nil {
+		return maybe.Nothing[V]()
+	}
+	return maybe.FromOK(m.root.get(hashOf(key), key))
+}
+
+// Has reports whether key is present
+func (m Map[K, V]) Has(key K) bool {
+	return m.Get(key).IsJust()
+}
+
+// Set returns a Map with key bound to value
+func (m Map[K, V]) Set(key K, value V) Map[K, V] {
+	root, added := set(m.root, nil, key, value)
+	if added {
+		return Map[K, V]{root: root, size: m.size + 1}
+	}
+	return Map[K, V]{root: root, size: m.size}
+}
+
+// Delete returns a Map without key; it returns m itself if key is absent
+func (m Map[K, V]) Delete(key K) Map[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, removed := m.root.remove(nil, hashOf(key), 0, key)
+	if !removed {
+		return m
+	}
+	return Map[K, V]{root: root, size: m.size - 1}
+}
+
+// All returns an iterator over the entries in an unspecified but stable order
+func (m Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.root != nil {
+			m.root.each(yield)
+		}
+	}
+}
+
+// Keys returns an iterator over the keys
+func (m Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap copies the entries into a Go map
+func (m Map[K, V]) ToMap() map[K]V {
+	out := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		out[k] = v
+	}
+	return out
+}
+
+// Builder returns a Builder starting from the entries of m
+func (m Map[K, V]) Builder() *Builder[K, V] {
+	return &Builder[K, V]{root: m.root, size: m.size, owner: &owner{}}
+}
+
+// Equal reports whether a and b hold the same keys with values equal under eq
+func Equal[K comparable, V any](a, b Map[K, V], eq func(V, V) bool) bool {
+	if a.size != b.size {
+		return false
+	}
+	if a.root == b.root {
+		return true
+	}
+	for k, v := range a.All() {
+		other, ok := b.root.get(hashOf(k), k)
+		if !ok || !eq(v, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualComparable is Equal using == on values
+func EqualComparable[K comparable, V comparable](a, b Map[K, V]) bool {
+	return Equal(a, b, func(x, y V) bool { return x == y })
+}
+
+func set[K comparable, V any](root *node[K, V], o *owner, key K, value V) (*node[K, V], bool) {
+	if root == nil {
+		root = &node[K, V]{owner: o}
+	}
+	return root.set(o, hashOf(key), 0, key, value)
+}
+
+// Builder is a transient Map for batches of updates. It modifies the nodes
+// it created in place instead of copying them. A Builder is not safe for
+// concurrent use.
+type Builder[K comparable, V any] struct {
+	root  *node[K, V]
+	size  int
+	owner *owner
+}
+
+// NewBuilder returns an empty Builder
+func NewBuilder[K comparable, V any]() *Builder[K, V] {
+	return Empty[K, V]().Builder()
+}
+
+// Len returns the number of entries
+func (b *Builder[K, V]) Len() int {
+	return b.size
+}
+
+// Get returns the value stored under key, or Nothing
+func (b *Builder[K, V]) Get(key K) maybe.Maybe[V] {
+	return Map[K, V]{root: b.root, size: b.size}.Get(key)
+}
+
+// Set binds key to value
+func (b *Builder[K, V]) Set(key K, value V) *Builder[K, V] {
+	root, added := set(b.root, b.owner, key, value)
+	b.root = root
+	if added {
+		b.size++
+	}
+	return b
+}
+
+// Delete removes key if present
+func (b *Builder[K, V]) Delete(key K) *Builder[K, V] {
+	if b.root == nil {
+		return b
+	}
+	root, removed := b.root.remove(b.owner, hashOf(key), 0, key)
+	if removed {
+		b.root = root
+		b.size--
+	}
+	return b
+}
+
+// Map returns the current entries as an immutable Map. The Builder stays
+// usable; later updates copy nodes rather than changing the returned Map.
+func (b *Builder[K, V]) Map() Map[K, V] {
+	b.owner = &owner{}
+	return Map[K, V]{root: b.root, size: b.size}
+}
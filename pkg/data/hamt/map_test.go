@@ -0,0 +1,241 @@
+package hamt
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("Set, Get and Delete return new versions", func(t *testing.T) {
+		var empty Map[string, int]
+		one := empty.Set("a", 1)
+		two := one.Set("b", 2)
+		updated := two.Set("a", 10)
+		removed := updated.Delete("b")
+
+		assert.Equal(t, 0, empty.Len())
+		assert.True(t, empty.Get("a").IsNothing())
+		assert.Equal(t, 1, one.Get("a").Get())
+		assert.False(t, one.Has("b"))
+		assert.Equal(t, 2, two.Len())
+		assert.Equal(t, 10, updated.Get("a").Get())
+		assert.Equal(t, 1, two.Get("a").Get())
+		assert.Equal(t, map[string]int{"a": 10}, removed.ToMap())
+		assert.Equal(t, removed, removed.Delete("missing"))
+	})
+
+	t.Run("matches a Go map under random operations", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		model := map[int]int{}
+		var m Map[int, int]
+		versions := []Map[int, int]{}
+		snapshots := []map[int]int{}
+
+		for i := range 20_000 {
+			k := rng.IntN(2_000)
+			if rng.IntN(3) == 0 {
+				delete(model, k)
+				m = m.Delete(k)
+			} else {
+				model[k] = i
+				m = m.Set(k, i)
+			}
+			if i%5_000 == 0 {
+				versions = append(versions, m)
+				snapshot := make(map[int]int, len(model))
+				for k, v := range model {
+					snapshot[k] = v
+				}
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+
+		require.Equal(t, len(model), m.Len())
+		assert.Equal(t, model, m.ToMap())
+		for i, v := range versions {
+			assert.Equal(t, snapshots[i], v.ToMap(), "version %d changed", i)
+		}
+	})
+
+	t.Run("deleting everything empties the trie", func(t *testing.T) {
+		m := FromMap(map[int]bool{1: true, 2: true, 3: true})
+		m = m.Delete(1).Delete(2).Delete(3)
+		assert.Equal(t, 0, m.Len())
+		assert.Nil(t, m.root)
+	})
+
+	t.Run("structural equality", func(t *testing.T) {
+		a := Empty[string, int]().Set("x", 1).Set("y", 2)
+		b := Empty[string, int]().Set("y", 2).Set("x", 1)
+		assert.True(t, EqualComparable(a, b))
+		assert.False(t, EqualComparable(a, b.Set("y", 3)))
+		assert.False(t, EqualComparable(a, b.Delete("y")))
+		assert.True(t, Equal(a, b.Set("x", -1), func(x, y int) bool { return x*x == y*y }))
+	})
+
+	t.Run("iteration stops early", func(t *testing.T) {
+		m := FromMap(map[int]int{1: 1, 2: 2, 3: 3})
+		seen := 0
+		for range m.All() {
+			seen++
+			break
+		}
+		assert.Equal(t, 1, seen)
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	t.Run("batches updates", func(t *testing.T) {
+		base := Empty[int, string]().Set(0, "zero")
+		b := base.Builder()
+		for i := 1; i <= 1_000; i++ {
+			b.Set(i, strconv.Itoa(i))
+		}
+		b.Delete(0)
+		m := b.Map()
+
+		assert.Equal(t, 1_000, m.Len())
+		assert.Equal(t, "500", m.Get(500).Get())
+		assert.Equal(t, 1, base.Len(), "the source Map must not change")
+	})
+
+	t.Run("frozen Maps are not changed by later updates", func(t *testing.T) {
+		b := NewBuilder[int, int]()
+		for i := range 100 {
+			b.Set(i, i)
+		}
+		frozen := b.Map()
+		for i := range 100 {
+			b.Set(i, -i)
+		}
+		b.Delete(5)
+
+		for i := range 100 {
+			assert.Equal(t, i, frozen.Get(i).Get())
+		}
+		assert.Equal(t, 99, b.Len())
+		assert.Equal(t, -7, b.Get(7).Get())
+	})
+}
+
+// TestCollisions drives the node layer with chosen hashes to cover full
+// 64-bit collisions and keys that share long hash prefixes
+func TestCollisions(t *testing.T) {
+	const shared = uint64(0xABCDEF0123456789)
+	var root *node[string, int]
+	insert := func(h uint64, k string, v int) bool {
+		if root == nil {
+			root = &node[string, int]{}
+		}
+		var added bool
+		root, added = root.set(nil, h, 0, k, v)
+		return added
+	}
+
+	assert.True(t, insert(shared, "a", 1))
+	assert.True(t, insert(shared, "b", 2))
+	assert.False(t, insert(shared, "a", 10))
+	assert.True(t, insert(shared^(1<<63), "c", 3))
+
+	get := func(h uint64, k string) (int, bool) { return root.get(h, k) }
+	v, ok := get(shared, "a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	v, _ = get(shared, "b")
+	assert.Equal(t, 2, v)
+	v, _ = get(shared^(1<<63), "c")
+	assert.Equal(t, 3, v)
+	_, ok = get(shared, "c")
+	assert.False(t, ok)
+
+	root, _ = root.remove(nil, shared, 0, "a")
+	_, ok = get(shared, "a")
+	assert.False(t, ok)
+	v, _ = get(shared, "b")
+	assert.Equal(t, 2, v)
+
+	root, _ = root.remove(nil, shared^(1<<63), 0, "c")
+	require.Len(t, root.slots, 1)
+	assert.Nil(t, root.slots[0].sub, "a lone leaf should be pulled up to the root")
+}
+
+func BenchmarkInsert(b *testing.B) {
+	const n = 10_000
+	b.Run("hamt.Map", func(b *testing.B) {
+		for range b.N {
+			var m Map[int, int]
+			for i := range n {
+				m = m.Set(i, i)
+			}
+		}
+	})
+	b.Run("hamt.Builder", func(b *testing.B) {
+		for range b.N {
+			bld := NewBuilder[int, int]()
+			for i := range n {
+				bld.Set(i, i)
+			}
+			_ = bld.Map()
+		}
+	})
+	b.Run("go map", func(b *testing.B) {
+		for range b.N {
+			m := map[int]int{}
+			for i := range n {
+				m[i] = i
+			}
+		}
+	})
+}
+
+func BenchmarkSnapshotUpdate(b *testing.B) {
+	const n = 10_000
+	persistent := NewBuilder[int, int]()
+	goMap := make(map[int]int, n)
+	for i := range n {
+		persistent.Set(i, i)
+		goMap[i] = i
+	}
+	m := persistent.Map()
+
+	b.Run("hamt.Map", func(b *testing.B) {
+		for i := range b.N {
+			_ = m.Set(i%n, i)
+		}
+	})
+	b.Run("go map copy", func(b *testing.B) {
+		for i := range b.N {
+			snapshot := make(map[int]int, len(goMap))
+			for k, v := range goMap {
+				snapshot[k] = v
+			}
+			snapshot[i%n] = i
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	const n = 10_000
+	bld := NewBuilder[int, int]()
+	goMap := make(map[int]int, n)
+	for i := range n {
+		bld.Set(i, i)
+		goMap[i] = i
+	}
+	m := bld.Map()
+
+	b.Run("hamt.Map", func(b *testing.B) {
+		for i := range b.N {
+			_ = m.Get(i % n)
+		}
+	})
+	b.Run("go map", func(b *testing.B) {
+		for i := range b.N {
+			_ = goMap[i%n]
+		}
+	})
+}
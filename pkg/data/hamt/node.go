@@ -0,0 +1,184 @@
+package hamt
+
+import (
+	"math/bits"
+	"slices"
+)
+
+const (
+	bitsPerLevel = 5
+	levelMask    = 1<<bitsPerLevel - 1
+)
+
+// owner marks the nodes a Builder may modify in place. It is not zero-sized
+// so that every owner has a distinct address.
+type owner struct{ _ int }
+
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+}
+
+// slot is either a subtree or a leaf. A leaf holds the entries whose full
+// hashes are equal: normally one, more only on a 64-bit collision.
+type slot[K comparable, V any] struct {
+	sub     *node[K, V]
+	entries []entry[K, V]
+}
+
+// node is a bitmap-indexed trie node: bit i of bitmap is set when the
+// 5-bit hash chunk i has a slot, stored at the popcount of the lower bits.
+type node[K comparable, V any] struct {
+	bitmap uint32
+	slots  []slot[K, V]
+	owner  *owner
+}
+
+func position(bitmap uint32, h uint64, shift uint) (bit uint32, idx int) {
+	bit = 1 << ((h >> shift) & levelMask)
+	return bit, bits.OnesCount32(bitmap & (bit - 1))
+}
+
+// edit returns n itself if it belongs to o, otherwise a copy owned by o
+func (n *node[K, V]) edit(o *owner) *node[K, V] {
+	if o != nil && n.owner == o {
+		return n
+	}
+	return &node[K, V]{bitmap: n.bitmap, slots: slices.Clone(n.slots), owner: o}
+}
+
+func (n *node[K, V]) get(h uint64, key K) (V, bool) {
+	for shift := uint(0); ; shift += bitsPerLevel {
+		bit, idx := position(n.bitmap, h, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+		s := &n.slots[idx]
+		if s.sub != nil {
+			n = s.sub
+			continue
+		}
+		for _, e := range s.entries {
+			if e.key == key {
+				return e.value, true
+			}
+		}
+		break
+	}
+	var zero V
+	return zero, false
+}
+
+// set returns the updated node and whether a new key was added
+func (n *node[K, V]) set(o *owner, h uint64, shift uint, key K, value V) (*node[K, V], bool) {
+	bit, idx := position(n.bitmap, h, shift)
+	if n.bitmap&bit == 0 {
+		out := n.edit(o)
+		out.bitmap |= bit
+		out.slots = slices.Insert(out.slots, idx, slot[K, V]{entries: []entry[K, V]{{h, key, value}}})
+		return out, true
+	}
+
+	s := n.slots[idx]
+	if s.sub != nil {
+		sub, added := s.sub.set(o, h, shift+bitsPerLevel, key, value)
+		out := n.edit(o)
+		out.slots[idx].sub = sub
+		return out, added
+	}
+
+	out := n.edit(o)
+	if s.entries[0].hash != h {
+		out.slots[idx] = slot[K, V]{sub: split(o, s.entries, entry[K, V]{h, key, value}, shift+bitsPerLevel)}
+		return out, true
+	}
+	for i, e := range s.entries {
+		if e.key == key {
+			entries := slices.Clone(s.entries)
+			entries[i].value = value
+			out.slots[idx].entries = entries
+			return out, false
+		}
+	}
+	out.slots[idx].entries = append(slices.Clip(s.entries), entry[K, V]{h, key, value})
+	return out, true
+}
+
+// split builds the subtree holding a leaf and a new entry whose hash differs
+func split[K comparable, V any](o *owner, leaf []entry[K, V], e entry[K, V], shift uint) *node[K, V] {
+	bitA, _ := position(0, leaf[0].hash, shift)
+	bitB, _ := position(0, e.hash, shift)
+	if bitA == bitB {
+		return &node[K, V]{bitmap: bitA, slots: []slot[K, V]{{sub: split(o, leaf, e, shift+bitsPerLevel)}}, owner: o}
+	}
+	a, b := slot[K, V]{entries: leaf}, slot[K, V]{entries: []entry[K, V]{e}}
+	if bitB < bitA {
+		a, b = b, a
+	}
+	return &node[K, V]{bitmap: bitA | bitB, slots: []slot[K, V]{a, b}, owner: o}
+}
+
+// remove returns the updated node, or nil if it became empty, and whether key was present
+func (n *node[K, V]) remove(o *owner, h uint64, shift uint, key K) (*node[K, V], bool) {
+	bit, idx := position(n.bitmap, h, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	s := n.slots[idx]
+	if s.sub != nil {
+		sub, removed := s.sub.remove(o, h, shift+bitsPerLevel, key)
+		if !removed {
+			return n, false
+		}
+		out := n.edit(o)
+		switch {
+		case sub == nil:
+			return out.without(bit, idx), true
+		case len(sub.slots) == 1 && sub.slots[0].sub == nil:
+			// a lone leaf moves up so the trie stays as shallow as possible
+			out.slots[idx] = sub.slots[0]
+		default:
+			out.slots[idx].sub = sub
+		}
+		return out, true
+	}
+
+	i := slices.IndexFunc(s.entries, func(e entry[K, V]) bool { return e.key == key })
+	if i < 0 {
+		return n, false
+	}
+	out := n.edit(o)
+	if len(s.entries) == 1 {
+		return out.without(bit, idx), true
+	}
+	out.slots[idx].entries = slices.Delete(slices.Clone(s.entries), i, i+1)
+	return out, true
+}
+
+func (n *node[K, V]) without(bit uint32, idx int) *node[K, V] {
+	n.bitmap &^= bit
+	n.slots = slices.Delete(n.slots, idx, idx+1)
+	if n.bitmap == 0 {
+		return nil
+	}
+	return n
+}
+
+func (n *node[K, V]) each(yield func(K, V) bool) bool {
+	for _, s := range n.slots {
+		if s.sub != nil {
+			if !s.sub.each(yield) {
+				return false
+			}
+			continue
+		}
+		for _, e := range s.entries {
+			if !yield(e.key, e.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package hamt
+
+import "iter"
+
+// Set is an immutable hash set. The zero value is an empty Set.
+type Set[K comparable] struct {
+	m Map[K, struct{}]
+}
+
+// NewSet creates a Set holding keys
+func NewSet[K comparable](keys ...K) Set[K] {
+	b := NewBuilder[K, struct{}]()
+	for _, k := range keys {
+		b.Set(k, struct{}{})
+	}
+	return Set[K]{m: b.Map()}
+}
+
+// Len returns the number of elements
+func (s Set[K]) Len() int {
+	return s.m.Len()
+}
+
+// Has reports whether key is an element
+func (s Set[K]) Has(key K) bool {
+	return s.m.Has(key)
+}
+
+// Add returns a Set that also holds key
+func (s Set[K]) Add(key K) Set[K] {
+	return Set[K]{m: s.m.Set(key, struct{}{})}
+}
+
+// Remove returns a Set without key
+func (s Set[K]) Remove(key K) Set[K] {
+	return Set[K]{m: s.m.Delete(key)}
+}
+
+// All returns an iterator over the elements in an unspecified but stable order
+func (s Set[K]) All() iter.Seq[K] {
+	return s.m.Keys()
+}
+
+// Union returns the elements of either Set, building on the larger one
+func (s Set[K]) Union(other Set[K]) Set[K] {
+	if s.Len() < other.Len() {
+		s, other = other, s
+	}
+	b := s.m.Builder()
+	for k := range other.All() {
+		b.Set(k, struct{}{})
+	}
+	return Set[K]{m: b.Map()}
+}
+
+// Intersect returns the elements present in both Sets
+func (s Set[K]) Intersect(other Set[K]) Set[K] {
+	if s.Len() > other.Len() {
+		s, other = other, s
+	}
+	b := NewBuilder[K, struct{}]()
+	for k := range s.All() {
+		if other.Has(k) {
+			b.Set(k, struct{}{})
+		}
+	}
+	return Set[K]{m: b.Map()}
+}
+
+// SetEqual reports whether two Sets hold the same elements
+func SetEqual[K comparable](a, b Set[K]) bool {
+	return EqualComparable(a.m, b.m)
+}
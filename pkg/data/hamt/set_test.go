@@ -0,0 +1,36 @@
+package hamt
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("Add, Has and Remove", func(t *testing.T) {
+		s := NewSet("a", "b")
+		added := s.Add("c")
+		removed := added.Remove("a")
+
+		assert.Equal(t, 2, s.Len())
+		assert.False(t, s.Has("c"))
+		assert.True(t, added.Has("c"))
+		assert.False(t, removed.Has("a"))
+		assert.Equal(t, 3, s.Add("c").Add("c").Len())
+	})
+
+	t.Run("Union and Intersect", func(t *testing.T) {
+		a, b := NewSet(1, 2, 3), NewSet(3, 4)
+
+		union := slices.Sorted(a.Union(b).All())
+		assert.Equal(t, []int{1, 2, 3, 4}, union)
+		assert.Equal(t, []int{3}, slices.Collect(a.Intersect(b).All()))
+		assert.Equal(t, 3, a.Len(), "Union must not change its inputs")
+	})
+
+	t.Run("SetEqual", func(t *testing.T) {
+		assert.True(t, SetEqual(NewSet(1, 2), NewSet(2, 1)))
+		assert.False(t, SetEqual(NewSet(1, 2), NewSet(1)))
+	})
+}
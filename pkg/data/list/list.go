@@ -0,0 +1,173 @@
+// Package list provides List, a persistent singly linked list.
+//
+// Lists are immutable: every operation returns a new List and shares the
+// unchanged tail with its input, so Lists can be passed between goroutines
+// without copying or locking.
+package list
+
+import (
+	"iter"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+	size  int
+}
+
+// List is an immutable sequence. The zero value is an empty List.
+type List[T any] struct {
+	head *node[T]
+}
+
+// Empty returns an empty List
+func Empty[T any]() List[T] {
+	return List[T]{}
+}
+
+// Of creates a List holding xs in order
+func Of[T any](xs ...T) List[T] {
+	return FromSlice(xs)
+}
+
+// FromSlice creates a List holding the elements of xs in order
+func FromSlice[T any](xs []T) List[T] {
+	l := Empty[T]()
+	for i := len(xs) - 1; i >= 0; i-- {
+		l = l.Cons(xs[i])
+	}
+	return l
+}
+
+// Cons returns a List with x in front of l, sharing l. It runs in O(1).
+func (l List[T]) Cons(x T) List[T] {
+	return List[T]{head: &node[T]{value: x, next: l.head, size: l.Len() + 1}}
+}
+
+// Head returns the first element, or Nothing for an empty List
+func (l List[T]) Head() maybe.Maybe[T] {
+	if l.head == nil {
+		return maybe.Nothing[T]()
+	}
+	return maybe.Just(l.head.value)
+}
+
+// Tail returns the List without its first element; the tail of an empty List is empty
+func (l List[T]) Tail() List[T] {
+	if l.head == nil {
+		return l
+	}
+	return List[T]{head: l.head.next}
+}
+
+// IsEmpty reports whether the List has no elements
+func (l List[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// Len returns the number of elements in O(1)
+func (l List[T]) Len() int {
+	if l.head == nil {
+		return 0
+	}
+	return l.head.size
+}
+
+// All returns an iterator over the elements in order
+func (l List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice copies the elements into a new slice
+func (l List[T]) ToSlice() []T {
+	out := make([]T, 0, l.Len())
+	for x := range l.All() {
+		out = append(out, x)
+	}
+	return out
+}
+
+// Reverse returns the elements in reverse order
+func (l List[T]) Reverse() List[T] {
+	return FoldLeft(l, Empty[T](), List[T].Cons)
+}
+
+// Map applies f to every element
+func Map[T, U any](l List[T], f func(T) U) List[U] {
+	return FoldRight(l, Empty[U](), func(x T, acc List[U]) List[U] {
+		return acc.Cons(f(x))
+	})
+}
+
+// FlatMap applies f to every element and concatenates the resulting Lists
+func FlatMap[T, U any](l List[T], f func(T) List[U]) List[U] {
+	return FoldRight(l, Empty[U](), func(x T, acc List[U]) List[U] {
+		return Concat(f(x), acc)
+	})
+}
+
+// Filter keeps the elements satisfying pred. The longest suffix whose
+// elements all pass is shared with l rather than copied.
+func Filter[T any](l List[T], pred func(T) bool) List[T] {
+	var kept []T
+	shared := l.head
+	for n := l.head; n != nil; n = n.next {
+		if !pred(n.value) {
+			for m := shared; m != n; m = m.next {
+				kept = append(kept, m.value)
+			}
+			shared = n.next
+		}
+	}
+	out := List[T]{head: shared}
+	for i := len(kept) - 1; i >= 0; i-- {
+		out = out.Cons(kept[i])
+	}
+	return out
+}
+
+// Concat returns the elements of a followed by those of b, sharing b
+func Concat[T any](a, b List[T]) List[T] {
+	if b.IsEmpty() {
+		return a
+	}
+	return FoldRight(a, b, func(x T, acc List[T]) List[T] { return acc.Cons(x) })
+}
+
+// FoldLeft combines the elements from first to last
+func FoldLeft[T, B any](l List[T], initial B, f func(B, T) B) B {
+	acc := initial
+	for x := range l.All() {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+// FoldRight combines the elements from last to first. It uses O(n) extra
+// space rather than recursion, so it is safe on long Lists.
+func FoldRight[T, B any](l List[T], initial B, f func(T, B) B) B {
+	xs := l.ToSlice()
+	acc := initial
+	for i := len(xs) - 1; i >= 0; i-- {
+		acc = f(xs[i], acc)
+	}
+	return acc
+}
+
+// Zip pairs the elements of two Lists, stopping at the shorter one
+func Zip[A, B any](as List[A], bs List[B]) List[tuple.Pair[A, B]] {
+	var pairs []tuple.Pair[A, B]
+	for a, b := as.head, bs.head; a != nil && b != nil; a, b = a.next, b.next {
+		pairs = append(pairs, tuple.NewPair(a.value, b.value))
+	}
+	return FromSlice(pairs)
+}
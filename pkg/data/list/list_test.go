@@ -0,0 +1,95 @@
+package list
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	t.Run("construction and access", func(t *testing.T) {
+		l := Of(1, 2, 3)
+		assert.Equal(t, 3, l.Len())
+		assert.Equal(t, 1, l.Head().Get())
+		assert.Equal(t, []int{2, 3}, l.Tail().ToSlice())
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(l.All()))
+
+		var empty List[int]
+		assert.True(t, empty.IsEmpty())
+		assert.True(t, empty.Head().IsNothing())
+		assert.True(t, empty.Tail().IsEmpty())
+		assert.Empty(t, empty.ToSlice())
+	})
+
+	t.Run("Cons shares structure", func(t *testing.T) {
+		history := Of(2, 3)
+		a := history.Cons(1)
+		b := history.Cons(10)
+
+		assert.Equal(t, []int{1, 2, 3}, a.ToSlice())
+		assert.Equal(t, []int{10, 2, 3}, b.ToSlice())
+		assert.Same(t, a.Tail().head, b.Tail().head)
+		assert.Equal(t, []int{2, 3}, history.ToSlice())
+	})
+
+	t.Run("FromSlice copies", func(t *testing.T) {
+		xs := []int{1, 2}
+		l := FromSlice(xs)
+		xs[0] = 9
+		assert.Equal(t, []int{1, 2}, l.ToSlice())
+	})
+
+	t.Run("Map, FlatMap and Filter", func(t *testing.T) {
+		l := Of(1, 2, 3, 4)
+		assert.Equal(t, []string{"1", "2", "3", "4"}, Map(l, strconv.Itoa).ToSlice())
+		assert.Equal(t, []int{1, 1, 2, 2}, FlatMap(Of(1, 2), func(x int) List[int] { return Of(x, x) }).ToSlice())
+
+		even := Filter(l, func(x int) bool { return x%2 == 0 })
+		assert.Equal(t, []int{2, 4}, even.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4}, l.ToSlice())
+
+		tail := Filter(l, func(x int) bool { return x > 2 })
+		assert.Same(t, l.Tail().Tail().head, tail.head)
+	})
+
+	t.Run("folds", func(t *testing.T) {
+		l := Of("a", "b", "c")
+		assert.Equal(t, "abc", FoldLeft(l, "", func(acc, x string) string { return acc + x }))
+		assert.Equal(t, "cba", FoldRight(l, "", func(x, acc string) string { return acc + x }))
+	})
+
+	t.Run("Reverse, Concat and Zip", func(t *testing.T) {
+		assert.Equal(t, []int{3, 2, 1}, Of(1, 2, 3).Reverse().ToSlice())
+		assert.Equal(t, []int{1, 2, 3}, Concat(Of(1), Of(2, 3)).ToSlice())
+		assert.Equal(t, 3, Concat(Of(1), Of(2, 3)).Len())
+
+		zipped := Zip(Of(1, 2, 3), Of("a", "b"))
+		assert.Equal(t, []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")}, zipped.ToSlice())
+	})
+
+	t.Run("long lists do not overflow the stack", func(t *testing.T) {
+		xs := make([]int, 1_000_000)
+		l := Map(FromSlice(xs), func(x int) int { return x + 1 })
+		assert.Equal(t, 1_000_000, l.Len())
+	})
+
+	t.Run("shared between goroutines", func(t *testing.T) {
+		events := Of("created")
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				mine := events.Cons(strconv.Itoa(i))
+				assert.Equal(t, 2, mine.Len())
+				assert.Equal(t, "created", mine.Tail().Head().Get())
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, events.Len())
+	})
+}
@@ -0,0 +1,173 @@
+// Package nonempty provides NonEmpty, a slice-like collection that always
+// holds at least one element, so Head, Last and Reduce never fail.
+package nonempty
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sync/atomic"
+
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+// NonEmpty holds one or more elements.
+// The zero value holds a single zero-valued element.
+type NonEmpty[T any] struct {
+	head T
+	tail []T
+	// claimed is shared by every NonEmpty whose tail uses the same backing
+	// array and records how much of that array is in use. Append writes in
+	// place only when it extends the longest claimed prefix, so repeated
+	// appends are amortised O(1) without versions overwriting each other.
+	claimed *atomic.Int64
+}
+
+// New creates a NonEmpty from its first element and any others
+func New[T any](head T, tail ...T) NonEmpty[T] {
+	return NonEmpty[T]{head: head, tail: slices.Clone(tail)}
+}
+
+// FromSlice returns Just the elements of xs, or Nothing if xs is empty
+func FromSlice[T any](xs []T) maybe.Maybe[NonEmpty[T]] {
+	if len(xs) == 0 {
+		return maybe.Nothing[NonEmpty[T]]()
+	}
+	return maybe.Just(New(xs[0], xs[1:]...))
+}
+
+// Head returns the first element
+func (n NonEmpty[T]) Head() T {
+	return n.head
+}
+
+// Last returns the last element
+func (n NonEmpty[T]) Last() T {
+	if len(n.tail) == 0 {
+		return n.head
+	}
+	return n.tail[len(n.tail)-1]
+}
+
+// Tail returns a copy of every element after the first, possibly empty
+func (n NonEmpty[T]) Tail() []T {
+	return slices.Clone(n.tail)
+}
+
+// Len returns the number of elements, always at least 1
+func (n NonEmpty[T]) Len() int {
+	return 1 + len(n.tail)
+}
+
+// ToSlice copies the elements into a new slice
+func (n NonEmpty[T]) ToSlice() []T {
+	return append([]T{n.head}, n.tail...)
+}
+
+// All returns an iterator over the elements in order
+func (n NonEmpty[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !yield(n.head) {
+			return
+		}
+		for _, x := range n.tail {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Append returns a NonEmpty with xs added at the end, leaving n unchanged.
+// Appending to the most recent version of a NonEmpty is amortised O(len(xs)).
+func (n NonEmpty[T]) Append(xs ...T) NonEmpty[T] {
+	if len(xs) == 0 {
+		return n
+	}
+	used, want := int64(len(n.tail)), int64(len(n.tail)+len(xs))
+	if n.claimed != nil && want <= int64(cap(n.tail)) && n.claimed.CompareAndSwap(used, want) {
+		return NonEmpty[T]{head: n.head, tail: append(n.tail, xs...), claimed: n.claimed}
+	}
+
+	tail := make([]T, 0, 2*int(want))
+	tail = append(append(tail, n.tail...), xs...)
+	claimed := new(atomic.Int64)
+	claimed.Store(want)
+	return NonEmpty[T]{head: n.head, tail: tail, claimed: claimed}
+}
+
+// Reduce combines the elements from first to last. No identity is needed
+// because there is always a first element.
+func (n NonEmpty[T]) Reduce(f func(T, T) T) T {
+	acc := n.head
+	for _, x := range n.tail {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+// Concat returns the elements of a followed by those of b.
+// It costs O(b.Len()) amortised, so folding from the left is linear overall.
+func Concat[T any](a, b NonEmpty[T]) NonEmpty[T] {
+	return a.Append(b.ToSlice()...)
+}
+
+// Semigroup combines NonEmpty values with Concat. NonEmpty has no identity,
+// so it is a Semigroup but not a Monoid; it suits error containers that
+// must hold at least one error, such as those accumulated by validated.
+func Semigroup[T any]() base.Semigroup[NonEmpty[T]] {
+	return base.SemigroupFunc[NonEmpty[T]](Concat[T])
+}
+
+// Map applies f to every element
+func Map[T, U any](n NonEmpty[T], f func(T) U) NonEmpty[U] {
+	tail := make([]U, len(n.tail))
+	for i, x := range n.tail {
+		tail[i] = f(x)
+	}
+	return NonEmpty[U]{head: f(n.head), tail: tail}
+}
+
+// FlatMap applies f to every element and concatenates the results
+func FlatMap[T, U any](n NonEmpty[T], f func(T) NonEmpty[U]) NonEmpty[U] {
+	first := f(n.head)
+	var tail []U
+	tail = append(tail, first.tail...)
+	for _, x := range n.tail {
+		next := f(x)
+		tail = append(tail, next.head)
+		tail = append(tail, next.tail...)
+	}
+	return NonEmpty[U]{head: first.head, tail: tail}
+}
+
+// Max returns the largest element
+func Max[T cmp.Ordered](n NonEmpty[T]) T {
+	return n.Reduce(func(a, b T) T { return max(a, b) })
+}
+
+// Min returns the smallest element
+func Min[T cmp.Ordered](n NonEmpty[T]) T {
+	return n.Reduce(func(a, b T) T { return min(a, b) })
+}
+
+// MaxFunc returns the first largest element according to cmp
+func MaxFunc[T any](n NonEmpty[T], cmp func(T, T) int) T {
+	return n.Reduce(func(a, b T) T {
+		if cmp(b, a) > 0 {
+			return b
+		}
+		return a
+	})
+}
+
+// MinFunc returns the first smallest element according to cmp
+func MinFunc[T any](n NonEmpty[T], cmp func(T, T) int) T {
+	return n.Reduce(func(a, b T) T {
+		if cmp(b, a) < 0 {
+			return b
+		}
+		return a
+	})
+}
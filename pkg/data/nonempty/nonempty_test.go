@@ -0,0 +1,116 @@
+package nonempty
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad/validated"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonEmpty(t *testing.T) {
+	t.Run("construction and access", func(t *testing.T) {
+		n := New(1, 2, 3)
+		assert.Equal(t, 1, n.Head())
+		assert.Equal(t, 3, n.Last())
+		assert.Equal(t, 3, n.Len())
+		assert.Equal(t, []int{2, 3}, n.Tail())
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(n.All()))
+
+		single := New("only")
+		assert.Equal(t, "only", single.Last())
+		assert.Empty(t, single.Tail())
+	})
+
+	t.Run("FromSlice", func(t *testing.T) {
+		assert.True(t, FromSlice([]int{}).IsNothing())
+		assert.Equal(t, []int{4, 5}, FromSlice([]int{4, 5}).Get().ToSlice())
+	})
+
+	t.Run("does not alias its inputs", func(t *testing.T) {
+		tail := []int{2, 3}
+		n := New(1, tail...)
+		tail[0] = 99
+		assert.Equal(t, []int{1, 2, 3}, n.ToSlice())
+
+		a := n.Append(4)
+		b := n.Append(5)
+		assert.Equal(t, []int{1, 2, 3, 4}, a.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 5}, b.ToSlice())
+
+		c := a.Append(6)
+		d := a.Append(7)
+		assert.Equal(t, []int{1, 2, 3, 4}, a.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4, 6}, c.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4, 7}, d.ToSlice())
+	})
+
+	t.Run("concurrent appends to one version", func(t *testing.T) {
+		base := New(0).Append(1)
+		var wg sync.WaitGroup
+		results := make([]NonEmpty[int], 8)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = base.Append(i + 10)
+			}()
+		}
+		wg.Wait()
+		for i, r := range results {
+			assert.Equal(t, []int{0, 1, i + 10}, r.ToSlice())
+		}
+	})
+
+	t.Run("accumulation is linear", func(t *testing.T) {
+		const n = 10_000
+		errs := Semigroup[int]()
+		allocs := testing.AllocsPerRun(1, func() {
+			acc := New(0)
+			for i := 1; i < n; i++ {
+				acc = errs.Combine(acc, New(i))
+			}
+		})
+		// Doubling growth allocates O(log n) backing arrays, not one per step
+		assert.Less(t, allocs, float64(100))
+
+		flat := FlatMap(New(0, slices.Repeat([]int{1}, 200_000)...), func(x int) NonEmpty[int] { return New(x, x) })
+		assert.Equal(t, 400_002, flat.Len())
+	})
+
+	t.Run("Reduce, Max and Min", func(t *testing.T) {
+		n := New(3, 1, 4, 1, 5)
+		assert.Equal(t, 14, n.Reduce(func(a, b int) int { return a + b }))
+		assert.Equal(t, 5, Max(n))
+		assert.Equal(t, 1, Min(n))
+
+		words := New("bb", "a", "ccc", "dd")
+		byLen := func(a, b string) int { return len(a) - len(b) }
+		assert.Equal(t, "ccc", MaxFunc(words, byLen))
+		assert.Equal(t, "a", MinFunc(words, byLen))
+	})
+
+	t.Run("Map and FlatMap", func(t *testing.T) {
+		assert.Equal(t, []string{"1", "2"}, Map(New(1, 2), strconv.Itoa).ToSlice())
+		assert.Equal(t, []int{1, 1, 2, 2}, FlatMap(New(1, 2), func(x int) NonEmpty[int] { return New(x, x) }).ToSlice())
+	})
+
+	t.Run("error container for validation", func(t *testing.T) {
+		errs := Semigroup[error]()
+		check := func(ok bool, msg string) validated.Validated[NonEmpty[error], bool] {
+			if !ok {
+				return validated.Invalid[NonEmpty[error], bool](New(errors.New(msg)))
+			}
+			return validated.Valid[NonEmpty[error]](true)
+		}
+
+		v := validated.Map2(errs, check(false, "name required"), check(false, "port invalid"), func(a, b bool) bool { return a && b })
+		failures, invalid := v.Errors()
+		assert.True(t, invalid)
+		assert.Equal(t, "name required", failures.Head().Error())
+		assert.Equal(t, []string{"name required", "port invalid"}, Map(failures, error.Error).ToSlice())
+	})
+}
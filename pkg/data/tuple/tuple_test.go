@@ -0,0 +1,24 @@
+package tuple
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple(t *testing.T) {
+	t.Run("Pair", func(t *testing.T) {
+		p := NewPair(1, "a")
+		a, b := p.Unpack()
+		assert.Equal(t, 1, a)
+		assert.Equal(t, "a", b)
+		assert.Equal(t, NewPair("a", 1), p.Swap())
+	})
+
+	t.Run("Triple", func(t *testing.T) {
+		a, b, c := NewTriple(1, "a", true).Unpack()
+		assert.Equal(t, 1, a)
+		assert.Equal(t, "a", b)
+		assert.True(t, c)
+	})
+}
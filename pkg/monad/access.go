@@ -0,0 +1,51 @@
+// Package monad holds definitions shared by the monad packages beneath it.
+package monad
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// AccessError is the panic value used when a value is read from the wrong
+// case of a sum type, such as Get on Nothing or GetRight on a Left.
+// Recover it with errors.As:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			var accessErr *monad.AccessError
+//			if err, ok := r.(error); ok && errors.As(err, &accessErr) { ... }
+//		}
+//	}()
+type AccessError struct {
+	Type   string // the accessed type, e.g. "maybe.Maybe[int]"
+	Op     string // the accessor, e.g. "Get"
+	Reason string // why the access failed, e.g. "value is Nothing"
+	Msg    string // caller-supplied context from a Must accessor, may be empty
+	File   string // call site of the accessor
+	Line   int
+	Func   string
+}
+
+// NewAccessError creates an AccessError recording the call site skip frames
+// above the function that calls NewAccessError
+func NewAccessError(skip int, typ, op, reason, msg string) *AccessError {
+	err := &AccessError{Type: typ, Op: op, Reason: reason, Msg: msg}
+	if pc, file, line, ok := runtime.Caller(skip + 1); ok {
+		err.File, err.Line = file, line
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			err.Func = fn.Name()
+		}
+	}
+	return err
+}
+
+func (e *AccessError) Error() string {
+	s := fmt.Sprintf("%s.%s: %s", e.Type, e.Op, e.Reason)
+	if e.Msg != "" {
+		s += ": " + e.Msg
+	}
+	if e.File != "" {
+		s += fmt.Sprintf(" (called at %s:%d)", e.File, e.Line)
+	}
+	return s
+}
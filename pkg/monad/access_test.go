@@ -0,0 +1,20 @@
+package monad
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessError(t *testing.T) {
+	err := NewAccessError(0, "maybe.Maybe[int]", "Get", "value is Nothing", "loading port")
+
+	assert.Equal(t, "TestAccessError", err.Func[strings.LastIndex(err.Func, ".")+1:])
+	assert.True(t, strings.HasSuffix(err.File, "access_test.go"))
+	assert.Positive(t, err.Line)
+	assert.True(t, strings.HasPrefix(err.Error(), "maybe.Maybe[int].Get: value is Nothing: loading port (called at "))
+
+	bare := &AccessError{Type: "T", Op: "Op", Reason: "bad"}
+	assert.Equal(t, "T.Op: bad", bare.Error())
+}
@@ -0,0 +1,42 @@
+package either
+
+// OrElse returns e if it is a Right, otherwise the result of calling f
+func OrElse[E any, A any](e Either[E, A], f func() Either[E, A]) Either[E, A] {
+	if e.isLeft {
+		return f()
+	}
+	return e
+}
+
+// Recover turns a Left into a Right by computing a value from the error
+func Recover[E any, A any](e Either[E, A], f func(E) A) Either[E, A] {
+	if e.isLeft {
+		return Right[E](f(e.left))
+	}
+	return e
+}
+
+// RecoverWith replaces a Left with the result of f, which may itself fail
+// with a different error type
+func RecoverWith[E any, F any, A any](e Either[E, A], f func(E) Either[F, A]) Either[F, A] {
+	if e.isLeft {
+		return f(e.left)
+	}
+	return Right[F](e.right)
+}
+
+// MapLeft applies a function to the Left value of an Either
+func MapLeft[E any, F any, A any](e Either[E, A], f func(E) F) Either[F, A] {
+	if e.isLeft {
+		return Left[F, A](f(e.left))
+	}
+	return Right[F](e.right)
+}
+
+// Swap exchanges the Left and Right sides
+func Swap[E any, A any](e Either[E, A]) Either[A, E] {
+	if e.isLeft {
+		return Right[A](e.left)
+	}
+	return Left[A, E](e.right)
+}
@@ -0,0 +1,68 @@
+package either
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestOrElse(t *testing.T) {
+	called := false
+	fallback := func() Either[string, int] {
+		called = true
+		return Right[string, int](10)
+	}
+
+	if OrElse(Right[string, int](5), fallback).GetRight() != 5 || called {
+		t.Error("OrElse on Right should not call the fallback")
+	}
+	if OrElse(Left[string, int]("e"), fallback).GetRight() != 10 {
+		t.Error("OrElse on Left should use the fallback")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	recovered := Recover(Left[string, int]("abc"), func(e string) int { return len(e) })
+	if recovered.GetRight() != 3 {
+		t.Error("Recover did not turn Left into Right")
+	}
+	if Recover(Right[string, int](1), func(string) int { return 0 }).GetRight() != 1 {
+		t.Error("Recover modified a Right")
+	}
+}
+
+func TestRecoverWith(t *testing.T) {
+	parse := func(s string) Either[error, int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Left[error, int](err)
+		}
+		return Right[error, int](n)
+	}
+
+	if RecoverWith(Left[string, int]("7"), parse).GetRight() != 7 {
+		t.Error("RecoverWith did not use the recovery result")
+	}
+	if !RecoverWith(Left[string, int]("x"), parse).IsLeft() {
+		t.Error("RecoverWith should keep a failed recovery")
+	}
+	if RecoverWith(Right[string, int](1), parse).GetRight() != 1 {
+		t.Error("RecoverWith modified a Right")
+	}
+}
+
+func TestMapLeftAndSwap(t *testing.T) {
+	mapped := MapLeft(Left[string, int]("abc"), func(e string) int { return len(e) })
+	if mapped.GetLeft() != 3 {
+		t.Error("MapLeft did not transform the Left")
+	}
+	if MapLeft(Right[string, int](1), func(e string) int { return len(e) }).GetRight() != 1 {
+		t.Error("MapLeft modified a Right")
+	}
+
+	if Swap(Left[string, int]("e")).GetRight() != "e" {
+		t.Error("Swap did not move Left to Right")
+	}
+	if Swap(Right[string, int](1)).GetLeft() != 1 {
+		t.Error("Swap did not move Right to Left")
+	}
+}
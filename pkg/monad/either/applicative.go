@@ -0,0 +1,85 @@
+package either
+
+import "github.com/kpse/go-cat/pkg/data/tuple"
+
+// Pure lifts a value into a Right
+func Pure[E any, A any](a A) Either[E, A] {
+	return Right[E](a)
+}
+
+// Ap applies an Either function to an Either value, returning the first Left
+func Ap[E any, A any, B any](ef Either[E, func(A) B], ea Either[E, A]) Either[E, B] {
+	if ef.isLeft {
+		return Left[E, B](ef.left)
+	}
+	if ea.isLeft {
+		return Left[E, B](ea.left)
+	}
+	return Right[E](ef.right(ea.right))
+}
+
+// Zip pairs the values of two Rights, returning the first Left
+func Zip[E any, A any, B any](ea Either[E, A], eb Either[E, B]) Either[E, tuple.Pair[A, B]] {
+	return Lift2(ea, eb, tuple.NewPair[A, B])
+}
+
+// Zip3 combines the values of three Rights into a Triple, returning the first Left
+func Zip3[E any, A any, B any, C any](ea Either[E, A], eb Either[E, B], ec Either[E, C]) Either[E, tuple.Triple[A, B, C]] {
+	return Lift3(ea, eb, ec, tuple.NewTriple[A, B, C])
+}
+
+// Lift2 applies f to the values of two Rights, returning the first Left
+func Lift2[E any, A any, B any, R any](ea Either[E, A], eb Either[E, B], f func(A, B) R) Either[E, R] {
+	switch {
+	case ea.isLeft:
+		return Left[E, R](ea.left)
+	case eb.isLeft:
+		return Left[E, R](eb.left)
+	}
+	return Right[E](f(ea.right, eb.right))
+}
+
+// Lift3 applies f to the values of three Rights, returning the first Left
+func Lift3[E any, A any, B any, C any, R any](ea Either[E, A], eb Either[E, B], ec Either[E, C], f func(A, B, C) R) Either[E, R] {
+	switch {
+	case ea.isLeft:
+		return Left[E, R](ea.left)
+	case eb.isLeft:
+		return Left[E, R](eb.left)
+	case ec.isLeft:
+		return Left[E, R](ec.left)
+	}
+	return Right[E](f(ea.right, eb.right, ec.right))
+}
+
+// Lift4 applies f to the values of four Rights, returning the first Left
+func Lift4[E any, A any, B any, C any, D any, R any](ea Either[E, A], eb Either[E, B], ec Either[E, C], ed Either[E, D], f func(A, B, C, D) R) Either[E, R] {
+	switch {
+	case ea.isLeft:
+		return Left[E, R](ea.left)
+	case eb.isLeft:
+		return Left[E, R](eb.left)
+	case ec.isLeft:
+		return Left[E, R](ec.left)
+	case ed.isLeft:
+		return Left[E, R](ed.left)
+	}
+	return Right[E](f(ea.right, eb.right, ec.right, ed.right))
+}
+
+// Lift5 applies f to the values of five Rights, returning the first Left
+func Lift5[E any, A any, B any, C any, D any, F any, R any](ea Either[E, A], eb Either[E, B], ec Either[E, C], ed Either[E, D], ef Either[E, F], f func(A, B, C, D, F) R) Either[E, R] {
+	switch {
+	case ea.isLeft:
+		return Left[E, R](ea.left)
+	case eb.isLeft:
+		return Left[E, R](eb.left)
+	case ec.isLeft:
+		return Left[E, R](ec.left)
+	case ed.isLeft:
+		return Left[E, R](ed.left)
+	case ef.isLeft:
+		return Left[E, R](ef.left)
+	}
+	return Right[E](f(ea.right, eb.right, ec.right, ed.right, ef.right))
+}
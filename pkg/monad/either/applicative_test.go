@@ -0,0 +1,52 @@
+package either
+
+import (
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+)
+
+func TestAp(t *testing.T) {
+	inc := Pure[string](func(x int) int { return x + 1 })
+	if Ap(inc, Right[string, int](5)).GetRight() != 6 {
+		t.Error("Ap on Rights did not apply the function")
+	}
+	if Ap(inc, Left[string, int]("e")).GetLeft() != "e" {
+		t.Error("Ap did not keep the value's Left")
+	}
+	if Ap(Left[string, func(int) int]("f"), Left[string, int]("e")).GetLeft() != "f" {
+		t.Error("Ap should return the function's Left first")
+	}
+}
+
+func TestZip(t *testing.T) {
+	pair := Zip(Right[string, int](1), Right[string, string]("a"))
+	if pair.GetRight() != tuple.NewPair(1, "a") {
+		t.Error("Zip did not pair the Right values")
+	}
+
+	triple := Zip3(Right[string, int](1), Left[string, string]("second"), Left[string, bool]("third"))
+	if triple.GetLeft() != "second" {
+		t.Error("Zip3 should return the first Left")
+	}
+}
+
+func TestLift(t *testing.T) {
+	r := func(x int) Either[string, int] { return Right[string, int](x) }
+	sum := Lift5(r(1), r(2), r(3), r(4), r(5), func(a, b, c, d, e int) int { return a + b + c + d + e })
+	if sum.GetRight() != 15 {
+		t.Error("Lift5 did not combine all Rights")
+	}
+
+	failed := Lift4(r(1), r(2), Left[string, int]("third"), r(4), func(a, b, c, d int) int { return a + b + c + d })
+	if failed.GetLeft() != "third" {
+		t.Error("Lift4 did not return the Left")
+	}
+
+	if Lift2(r(1), r(2), func(a, b int) int { return a * b }).GetRight() != 2 {
+		t.Error("Lift2 did not apply the function")
+	}
+	if Lift3(r(1), r(2), r(3), func(a, b, c int) int { return a * b * c }).GetRight() != 6 {
+		t.Error("Lift3 did not apply the function")
+	}
+}
@@ -0,0 +1,43 @@
+package either
+
+import "cmp"
+
+// Equal reports whether a and b are on the same side with equal values
+func Equal[E any, A any](a, b Either[E, A], eqLeft func(E, E) bool, eqRight func(A, A) bool) bool {
+	if a.isLeft != b.isLeft {
+		return false
+	}
+	if a.isLeft {
+		return eqLeft(a.left, b.left)
+	}
+	return eqRight(a.right, b.right)
+}
+
+// EqualComparable is Equal using == on both sides
+func EqualComparable[E comparable, A comparable](a, b Either[E, A]) bool {
+	if a.isLeft != b.isLeft {
+		return false
+	}
+	if a.isLeft {
+		return a.left == b.left
+	}
+	return a.right == b.right
+}
+
+// Compare orders Eithers with every Left before every Right, then by the side's comparison
+func Compare[E any, A any](a, b Either[E, A], cmpLeft func(E, E) int, cmpRight func(A, A) int) int {
+	switch {
+	case a.isLeft && !b.isLeft:
+		return -1
+	case !a.isLeft && b.isLeft:
+		return 1
+	case a.isLeft:
+		return cmpLeft(a.left, b.left)
+	}
+	return cmpRight(a.right, b.right)
+}
+
+// CompareOrdered is Compare using cmp.Compare, and can be passed directly to slices.SortFunc
+func CompareOrdered[E cmp.Ordered, A cmp.Ordered](a, b Either[E, A]) int {
+	return Compare(a, b, cmp.Compare[E], cmp.Compare[A])
+}
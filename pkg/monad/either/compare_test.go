@@ -0,0 +1,39 @@
+package either
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	if !EqualComparable(Right[string, int](1), Right[string, int](1)) {
+		t.Error("equal Rights should be equal")
+	}
+	if EqualComparable(Left[int, int](1), Right[int, int](1)) {
+		t.Error("Left and Right with the same value should differ")
+	}
+	if !Equal(Left[string, int]("A"), Left[string, int]("a"), strings.EqualFold, func(a, b int) bool { return a == b }) {
+		t.Error("Equal should use the Left comparison")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	es := []Either[string, int]{
+		Right[string, int](2),
+		Left[string, int]("b"),
+		Right[string, int](1),
+		Left[string, int]("a"),
+	}
+	slices.SortFunc(es, CompareOrdered[string, int])
+
+	want := []Either[string, int]{
+		Left[string, int]("a"),
+		Left[string, int]("b"),
+		Right[string, int](1),
+		Right[string, int](2),
+	}
+	if !slices.EqualFunc(es, want, EqualComparable[string, int]) {
+		t.Errorf("unexpected order: %v", es)
+	}
+}
@@ -0,0 +1,24 @@
+package either
+
+import "github.com/kpse/go-cat/pkg/monad/maybe"
+
+// FromMaybe converts a Maybe into an Either, using left when it is Nothing
+func FromMaybe[E any, A any](m maybe.Maybe[A], left E) Either[E, A] {
+	if m.IsNothing() {
+		return Left[E, A](left)
+	}
+	return Right[E](m.Get())
+}
+
+// ToMaybe keeps the Right value and discards a Left
+func ToMaybe[E any, A any](e Either[E, A]) maybe.Maybe[A] {
+	if e.isLeft {
+		return maybe.Nothing[A]()
+	}
+	return maybe.Just(e.right)
+}
+
+// FromPtr returns Right the pointed-to value, or Left for a nil pointer
+func FromPtr[E any, A any](p *A, left E) Either[E, A] {
+	return FromMaybe(maybe.FromPtr(p), left)
+}
@@ -0,0 +1,35 @@
+package either
+
+import (
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+func TestFromMaybe(t *testing.T) {
+	if FromMaybe(maybe.Just(0), "missing").GetRight() != 0 {
+		t.Error("FromMaybe on Just should keep zero values")
+	}
+	if FromMaybe(maybe.Nothing[int](), "missing").GetLeft() != "missing" {
+		t.Error("FromMaybe on Nothing should use the Left value")
+	}
+}
+
+func TestToMaybe(t *testing.T) {
+	if ToMaybe(Right[string, int](1)).Get() != 1 {
+		t.Error("ToMaybe on Right should be Just")
+	}
+	if !ToMaybe(Left[string, int]("e")).IsNothing() {
+		t.Error("ToMaybe on Left should be Nothing")
+	}
+}
+
+func TestFromPtr(t *testing.T) {
+	zero := 0
+	if FromPtr(&zero, "nil").GetRight() != 0 {
+		t.Error("FromPtr should accept pointers to zero values")
+	}
+	if FromPtr[string, int](nil, "nil").GetLeft() != "nil" {
+		t.Error("FromPtr on nil should be Left")
+	}
+}
@@ -1,17 +1,35 @@
+// Package either provides Either, a value holding exactly one of two types.
+//
+// Either's fields are unexported so that a value can only be built through
+// Left and Right and can never hold both sides at once. The zero value is a
+// Right holding the zero A. Code that used the former exported fields
+// migrates as follows:
+//
+//	e.Left             -> e.GetLeft() or e.LeftValue()
+//	e.Right            -> e.GetRight() or e.RightValue()
+//	Either[E, A]{...}  -> Left[E, A](x) or Right[E, A](x)
 package either
 
+import (
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/kpse/go-cat/pkg/monad"
+)
+
 // Either represents a value of one of two possible types (a disjoint union)
 type Either[E any, A any] struct {
 	isLeft bool
-	Left   E
-	Right  A
+	left   E
+	right  A
 }
 
 // Left creates a new Either with a Left value
 func Left[E any, A any](e E) Either[E, A] {
 	return Either[E, A]{
 		isLeft: true,
-		Left:   e,
+		left:   e,
 	}
 }
 
@@ -19,7 +37,7 @@ func Left[E any, A any](e E) Either[E, A] {
 func Right[E any, A any](a A) Either[E, A] {
 	return Either[E, A]{
 		isLeft: false,
-		Right:  a,
+		right:  a,
 	}
 }
 
@@ -34,48 +52,95 @@ func (e Either[E, A]) IsRight() bool {
 }
 
 // GetLeft returns the Left value
-// Panics if the Either is a Right
+// Panics with a *monad.AccessError if the Either is a Right
 func (e Either[E, A]) GetLeft() E {
 	if !e.isLeft {
-		panic("Called GetLeft on Right value")
+		panic(e.accessError("GetLeft", ""))
 	}
-	return e.Left
+	return e.left
 }
 
 // GetRight returns the Right value
-// Panics if the Either is a Left
+// Panics with a *monad.AccessError if the Either is a Left
 func (e Either[E, A]) GetRight() A {
 	if e.isLeft {
-		panic("Called GetRight on Left value")
+		panic(e.accessError("GetRight", ""))
 	}
-	return e.Right
+	return e.right
+}
+
+// MustGetLeft is GetLeft with a message describing the caller's expectation
+func (e Either[E, A]) MustGetLeft(msg string) E {
+	if !e.isLeft {
+		panic(e.accessError("MustGetLeft", msg))
+	}
+	return e.left
+}
+
+// MustGetRight is GetRight with a message describing the caller's expectation
+func (e Either[E, A]) MustGetRight(msg string) A {
+	if e.isLeft {
+		panic(e.accessError("MustGetRight", msg))
+	}
+	return e.right
+}
+
+func (e Either[E, A]) accessError(op, msg string) *monad.AccessError {
+	typ := fmt.Sprintf("either.Either[%s, %s]", reflect.TypeFor[E](), reflect.TypeFor[A]())
+	reason := "value is Right"
+	if e.isLeft {
+		reason = "value is Left"
+	}
+	return monad.NewAccessError(2, typ, op, reason, msg)
+}
+
+// LeftValue returns the Left value and true, or the zero E and false for a Right
+func (e Either[E, A]) LeftValue() (E, bool) {
+	if !e.isLeft {
+		var zero E
+		return zero, false
+	}
+	return e.left, true
+}
+
+// RightValue returns the Right value and true, or the zero A and false for a Left
+func (e Either[E, A]) RightValue() (A, bool) {
+	if e.isLeft {
+		var zero A
+		return zero, false
+	}
+	return e.right, true
 }
 
 // Map applies a function to the Right value of an Either
 func Map[E any, A any, B any](e Either[E, A], f func(A) B) Either[E, B] {
 	if e.isLeft {
-		return Left[E, B](e.Left)
+		return Left[E, B](e.left)
 	}
-	return Right[E, B](f(e.Right))
+	return Right[E, B](f(e.right))
 }
 
 // Bind chains computations with possible failures
 func Bind[E any, A any, B any](e Either[E, A], f func(A) Either[E, B]) Either[E, B] {
 	if e.isLeft {
-		return Left[E, B](e.Left)
+		return Left[E, B](e.left)
 	}
-	return f(e.Right)
+	return f(e.right)
 }
 
 // Match pattern matches on Either, applying the appropriate function
 func Match[E any, A any, B any](e Either[E, A], leftFn func(E) B, rightFn func(A) B) B {
 	if e.isLeft {
-		return leftFn(e.Left)
+		return leftFn(e.left)
 	}
-	return rightFn(e.Right)
+	return rightFn(e.right)
 }
 
 // FromNillable creates an Either from a potentially nil value
+//
+// Deprecated: FromNillable treats every zero value as missing, so
+// FromNillable(0, err) is a Left. Use FromPtr for pointers, or
+// FromMaybe(maybe.FromZero(v), err) when zero really means absent.
 func FromNillable[E any, A comparable](value A, err E) Either[E, A] {
 	var zero A
 	if value == zero {
@@ -87,9 +152,9 @@ func FromNillable[E any, A comparable](value A, err E) Either[E, A] {
 // BiMap applies functions to both sides of the Either
 func BiMap[E1 any, E2 any, A1 any, A2 any](e Either[E1, A1], leftFn func(E1) E2, rightFn func(A1) A2) Either[E2, A2] {
 	if e.isLeft {
-		return Left[E2, A2](leftFn(e.Left))
+		return Left[E2, A2](leftFn(e.left))
 	}
-	return Right[E2, A2](rightFn(e.Right))
+	return Right[E2, A2](rightFn(e.right))
 }
 
 // Fold reduces the Either to a single value
@@ -97,5 +162,14 @@ func Fold[E any, A any, B any](e Either[E, A], initial B, f func(B, A) B) B {
 	if e.isLeft {
 		return initial
 	}
-	return f(initial, e.Right)
+	return f(initial, e.right)
+}
+
+// All returns an iterator yielding the Right value, or nothing for a Left
+func (e Either[E, A]) All() iter.Seq[A] {
+	return func(yield func(A) bool) {
+		if !e.isLeft {
+			yield(e.right)
+		}
+	}
 }
@@ -1,8 +1,12 @@
 package either
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad"
 )
 
 func TestEitherConstructors(t *testing.T) {
@@ -195,3 +199,76 @@ func TestFold(t *testing.T) {
 		t.Error("Fold on Left value didn't return initial value")
 	}
 }
+
+func TestValueAccessors(t *testing.T) {
+	left := Left[string, int]("error")
+	if e, ok := left.LeftValue(); !ok || e != "error" {
+		t.Error("LeftValue on Left did not return the value")
+	}
+	if a, ok := left.RightValue(); ok || a != 0 {
+		t.Error("RightValue on Left should return zero and false")
+	}
+
+	right := Right[string, int](42)
+	if a, ok := right.RightValue(); !ok || a != 42 {
+		t.Error("RightValue on Right did not return the value")
+	}
+	if e, ok := right.LeftValue(); ok || e != "" {
+		t.Error("LeftValue on Right should return zero and false")
+	}
+}
+
+func TestZeroValueIsRight(t *testing.T) {
+	var zero Either[string, int]
+	if !zero.IsRight() {
+		t.Error("zero Either should be a Right")
+	}
+	if a, ok := zero.RightValue(); !ok || a != 0 {
+		t.Error("zero Either should hold the zero Right value")
+	}
+}
+
+func TestAll(t *testing.T) {
+	var got []int
+	for v := range Right[string, int](7).All() {
+		got = append(got, v)
+	}
+	if len(got) != 1 || got[0] != 7 {
+		t.Error("All on Right should yield the value once")
+	}
+
+	for range Left[string, int]("e").All() {
+		t.Error("All on Left should yield nothing")
+	}
+}
+
+func TestAccessErrorPanics(t *testing.T) {
+	recovered := func(f func()) (r any) {
+		defer func() { r = recover() }()
+		f()
+		return nil
+	}
+
+	r := recovered(func() { Left[string, int]("e").MustGetRight("expected a parsed port") })
+	err, ok := r.(error)
+	if !ok {
+		t.Fatalf("panic value %v is not an error", r)
+	}
+	var accessErr *monad.AccessError
+	if !errors.As(err, &accessErr) {
+		t.Fatal("panic value is not an AccessError")
+	}
+	if accessErr.Type != "either.Either[string, int]" || accessErr.Op != "MustGetRight" || accessErr.Reason != "value is Left" {
+		t.Errorf("unexpected AccessError: %v", accessErr)
+	}
+	if accessErr.Msg != "expected a parsed port" || !strings.HasSuffix(accessErr.File, "either_test.go") {
+		t.Errorf("AccessError missing context: %v", accessErr)
+	}
+
+	if _, ok := recovered(func() { Right[string, int](1).GetLeft() }).(*monad.AccessError); !ok {
+		t.Error("GetLeft on Right should panic with an AccessError")
+	}
+	if Left[string, int]("e").MustGetLeft("unused") != "e" {
+		t.Error("MustGetLeft on Left should return the value")
+	}
+}
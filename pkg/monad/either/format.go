@@ -0,0 +1,33 @@
+package either
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String renders the Either as Left(value) or Right(value)
+func (e Either[E, A]) String() string {
+	return fmt.Sprint(e)
+}
+
+// GoString renders the Either as the Go expression that builds it
+func (e Either[E, A]) GoString() string {
+	return fmt.Sprintf("%#v", e)
+}
+
+// Format implements fmt.Formatter. The verb and flags are applied to the
+// held value, so %+v and %#v reach nested values; %#v prints Go syntax.
+func (e Either[E, A]) Format(f fmt.State, verb rune) {
+	var held any = e.right
+	name := "Right"
+	if e.isLeft {
+		held = e.left
+		name = "Left"
+	}
+
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "either.%s[%s, %s](%#v)", name, reflect.TypeFor[E](), reflect.TypeFor[A](), held)
+		return
+	}
+	fmt.Fprintf(f, name+"("+fmt.FormatString(f, verb)+")", held)
+}
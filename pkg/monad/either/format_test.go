@@ -0,0 +1,31 @@
+package either
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+
+	cases := []struct {
+		got  string
+		want string
+	}{
+		{Right[string, int](1).String(), "Right(1)"},
+		{Left[error, int](errors.New("boom")).String(), "Left(boom)"},
+		{Right[string, int](1).GoString(), "either.Right[string, int](1)"},
+		{Left[string, int]("e").GoString(), `either.Left[string, int]("e")`},
+		{fmt.Sprintf("%+v", Right[string, point](point{1, 2})), "Right({X:1 Y:2})"},
+		{fmt.Sprintf("%#v", Right[string, point](point{1, 2})), "either.Right[string, either.point](either.point{X:1, Y:2})"},
+		{fmt.Sprintf("%v", Right[string, Either[string, int]](Left[string, int]("inner"))), "Right(Left(inner))"},
+		{fmt.Sprintf("%q", Left[string, int]("e")), `Left("e")`},
+	}
+
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("got %s, want %s", c.got, c.want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package either
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONStyle selects how an Either is laid out in JSON
+type JSONStyle int
+
+const (
+	// Wrapped encodes as {"left": …} or {"right": …}
+	Wrapped JSONStyle = iota
+	// Discriminated encodes as {"<tag>": "left"|"right", "<value>": …}
+	Discriminated
+	// Untagged encodes the bare value; decoding tries Right first, then Left
+	Untagged
+)
+
+// JSONOptions configures MarshalJSONWith and UnmarshalJSONWith.
+// Empty names fall back to DefaultJSONOptions.
+type JSONOptions struct {
+	Style      JSONStyle
+	LeftKey    string // key (Wrapped) or tag value (Discriminated) for Left
+	RightKey   string // key (Wrapped) or tag value (Discriminated) for Right
+	TagField   string // discriminator field name, Discriminated only
+	ValueField string // payload field name, Discriminated only
+}
+
+// DefaultJSONOptions is the encoding used by Either's MarshalJSON and UnmarshalJSON
+var DefaultJSONOptions = JSONOptions{
+	Style:      Wrapped,
+	LeftKey:    "left",
+	RightKey:   "right",
+	TagField:   "type",
+	ValueField: "value",
+}
+
+func (o JSONOptions) withDefaults() JSONOptions {
+	if o.LeftKey == "" {
+		o.LeftKey = DefaultJSONOptions.LeftKey
+	}
+	if o.RightKey == "" {
+		o.RightKey = DefaultJSONOptions.RightKey
+	}
+	if o.TagField == "" {
+		o.TagField = DefaultJSONOptions.TagField
+	}
+	if o.ValueField == "" {
+		o.ValueField = DefaultJSONOptions.ValueField
+	}
+	return o
+}
+
+// MarshalJSON encodes the Either using DefaultJSONOptions
+func (e Either[E, A]) MarshalJSON() ([]byte, error) {
+	return MarshalJSONWith(e, DefaultJSONOptions)
+}
+
+// UnmarshalJSON decodes the Either using DefaultJSONOptions.
+// As with other encoding/json types, a JSON null leaves e unchanged.
+func (e *Either[E, A]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	decoded, err := UnmarshalJSONWith[E, A](data, DefaultJSONOptions)
+	if err != nil {
+		return err
+	}
+	*e = decoded
+	return nil
+}
+
+// MarshalJSONWith encodes the Either in the layout described by opts
+func MarshalJSONWith[E any, A any](e Either[E, A], opts JSONOptions) ([]byte, error) {
+	opts = opts.withDefaults()
+
+	var payload any = e.right
+	tag := opts.RightKey
+	if e.isLeft {
+		payload = e.left
+		tag = opts.LeftKey
+	}
+
+	switch opts.Style {
+	case Wrapped:
+		return json.Marshal(map[string]any{tag: payload})
+	case Discriminated:
+		return json.Marshal(map[string]any{opts.TagField: tag, opts.ValueField: payload})
+	case Untagged:
+		return json.Marshal(payload)
+	default:
+		return nil, fmt.Errorf("either: unknown JSON style %d", opts.Style)
+	}
+}
+
+// UnmarshalJSONWith decodes an Either from the layout described by opts.
+// Untagged decoding rejects unknown object fields so that struct-shaped
+// sides can be told apart; when both sides accept the input, Right wins.
+func UnmarshalJSONWith[E any, A any](data []byte, opts JSONOptions) (Either[E, A], error) {
+	opts = opts.withDefaults()
+
+	switch opts.Style {
+	case Wrapped:
+		return decodeWrapped[E, A](data, opts)
+	case Discriminated:
+		return decodeDiscriminated[E, A](data, opts)
+	case Untagged:
+		return decodeUntagged[E, A](data)
+	default:
+		return Either[E, A]{}, fmt.Errorf("either: unknown JSON style %d", opts.Style)
+	}
+}
+
+func decodeWrapped[E any, A any](data []byte, opts JSONOptions) (Either[E, A], error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return Either[E, A]{}, fmt.Errorf("either: %w", err)
+	}
+	if len(fields) == 1 {
+		if raw, ok := fields[opts.LeftKey]; ok {
+			return decodeSide[E, A](raw, true, false)
+		}
+		if raw, ok := fields[opts.RightKey]; ok {
+			return decodeSide[E, A](raw, false, false)
+		}
+	}
+	return Either[E, A]{}, fmt.Errorf("either: expected exactly one of %q or %q", opts.LeftKey, opts.RightKey)
+}
+
+func decodeDiscriminated[E any, A any](data []byte, opts JSONOptions) (Either[E, A], error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return Either[E, A]{}, fmt.Errorf("either: %w", err)
+	}
+	rawTag, ok := fields[opts.TagField]
+	if !ok {
+		return Either[E, A]{}, fmt.Errorf("either: missing discriminator %q", opts.TagField)
+	}
+	var tag string
+	if err := json.Unmarshal(rawTag, &tag); err != nil {
+		return Either[E, A]{}, fmt.Errorf("either: discriminator %q: %w", opts.TagField, err)
+	}
+	raw, ok := fields[opts.ValueField]
+	if !ok {
+		return Either[E, A]{}, fmt.Errorf("either: missing value field %q", opts.ValueField)
+	}
+	switch tag {
+	case opts.LeftKey:
+		return decodeSide[E, A](raw, true, false)
+	case opts.RightKey:
+		return decodeSide[E, A](raw, false, false)
+	default:
+		return Either[E, A]{}, fmt.Errorf("either: unknown discriminator value %q", tag)
+	}
+}
+
+func decodeUntagged[E any, A any](data []byte) (Either[E, A], error) {
+	right, rightErr := decodeSide[E, A](data, false, true)
+	if rightErr == nil {
+		return right, nil
+	}
+	left, leftErr := decodeSide[E, A](data, true, true)
+	if leftErr == nil {
+		return left, nil
+	}
+	return Either[E, A]{}, errors.Join(rightErr, leftErr)
+}
+
+func decodeSide[E any, A any](raw []byte, left bool, strict bool) (Either[E, A], error) {
+	if left {
+		var e E
+		if err := decodeValue(raw, &e, strict); err != nil {
+			return Either[E, A]{}, fmt.Errorf("either: decoding left: %w", err)
+		}
+		return Left[E, A](e), nil
+	}
+	var a A
+	if err := decodeValue(raw, &a, strict); err != nil {
+		return Either[E, A]{}, fmt.Errorf("either: decoding right: %w", err)
+	}
+	return Right[E, A](a), nil
+}
+
+// decodeValue decodes exactly one JSON value from raw into v
+func decodeValue(raw []byte, v any, strict bool) error {
+	if !strict {
+		return json.Unmarshal(raw, v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after top-level value")
+	}
+	return nil
+}
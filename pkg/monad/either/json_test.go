@@ -0,0 +1,152 @@
+package either
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONDefaultWrapped(t *testing.T) {
+	right, err := json.Marshal(Right[string, int](42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(right) != `{"right":42}` {
+		t.Errorf("unexpected Right encoding: %s", right)
+	}
+
+	left, err := json.Marshal(Left[string, int]("boom"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(left) != `{"left":"boom"}` {
+		t.Errorf("unexpected Left encoding: %s", left)
+	}
+
+	var decoded Either[string, int]
+	if err := json.Unmarshal(left, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsLeft() || decoded.GetLeft() != "boom" {
+		t.Error("Left did not round-trip")
+	}
+
+	if err := json.Unmarshal([]byte(`{"left":"a","right":1}`), &decoded); err == nil {
+		t.Error("decoding both sides should fail")
+	}
+	if err := json.Unmarshal([]byte(`{}`), &decoded); err == nil {
+		t.Error("decoding neither side should fail")
+	}
+}
+
+func TestJSONInsideStruct(t *testing.T) {
+	type response struct {
+		ID     int                   `json:"id"`
+		Result Either[string, []int] `json:"result"`
+	}
+
+	data, err := json.Marshal(response{ID: 1, Result: Right[string, []int]([]int{1, 2})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"id":1,"result":{"right":[1,2]}}` {
+		t.Errorf("unexpected encoding: %s", data)
+	}
+
+	var decoded response
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Result.IsRight() || len(decoded.Result.GetRight()) != 2 {
+		t.Error("nested Either did not round-trip")
+	}
+}
+
+func TestJSONDiscriminated(t *testing.T) {
+	opts := JSONOptions{Style: Discriminated, TagField: "kind", LeftKey: "err", RightKey: "ok"}
+
+	data, err := MarshalJSONWith(Left[string, int]("boom"), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"kind":"err","value":"boom"}` {
+		t.Errorf("unexpected encoding: %s", data)
+	}
+
+	decoded, err := UnmarshalJSONWith[string, int]([]byte(`{"kind":"ok","value":7,"extra":true}`), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsRight() || decoded.GetRight() != 7 {
+		t.Error("Discriminated Right not decoded")
+	}
+
+	if _, err := UnmarshalJSONWith[string, int]([]byte(`{"kind":"maybe","value":7}`), opts); err == nil {
+		t.Error("unknown discriminator should fail")
+	}
+	if _, err := UnmarshalJSONWith[string, int]([]byte(`{"value":7}`), opts); err == nil {
+		t.Error("missing discriminator should fail")
+	}
+}
+
+func TestJSONUntagged(t *testing.T) {
+	type apiError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	type user struct {
+		Name string `json:"name"`
+	}
+	opts := JSONOptions{Style: Untagged}
+
+	data, err := MarshalJSONWith(Right[apiError, user](user{Name: "ann"}), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"ann"}` {
+		t.Errorf("unexpected encoding: %s", data)
+	}
+
+	right, err := UnmarshalJSONWith[apiError, user](data, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !right.IsRight() || right.GetRight().Name != "ann" {
+		t.Error("Untagged Right not decoded")
+	}
+
+	left, err := UnmarshalJSONWith[apiError, user]([]byte(`{"code":404,"message":"missing"}`), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !left.IsLeft() || left.GetLeft().Code != 404 {
+		t.Error("Untagged input not matching Right should fall back to Left")
+	}
+
+	if _, err := UnmarshalJSONWith[int, bool]([]byte(`"text"`), opts); err == nil {
+		t.Error("input matching neither side should fail")
+	}
+	if _, err := UnmarshalJSONWith[string, int]([]byte(`1 garbage`), opts); err == nil {
+		t.Error("trailing data after the value should fail")
+	}
+	if _, err := UnmarshalJSONWith[string, int]([]byte(`1 2`), opts); err == nil {
+		t.Error("a second value should fail")
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	type response struct {
+		Result Either[string, int] `json:"result"`
+	}
+
+	decoded := response{Result: Right[string, int](7)}
+	if err := json.Unmarshal([]byte(`{"result":null}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Result.IsRight() || decoded.Result.GetRight() != 7 {
+		t.Error("null should leave the Either unchanged")
+	}
+
+	if _, err := UnmarshalJSONWith[string, int]([]byte(`null`), DefaultJSONOptions); err == nil {
+		t.Error("UnmarshalJSONWith should still reject null")
+	}
+}
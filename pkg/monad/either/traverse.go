@@ -0,0 +1,65 @@
+package either
+
+// Traverse applies f to every element, returning all Right results or the first Left
+func Traverse[E any, A any, B any](xs []A, f func(A) Either[E, B]) Either[E, []B] {
+	out := make([]B, 0, len(xs))
+	for _, x := range xs {
+		e := f(x)
+		if e.isLeft {
+			return Left[E, []B](e.left)
+		}
+		out = append(out, e.right)
+	}
+	return Right[E](out)
+}
+
+// Sequence turns a slice of Eithers into an Either of a slice, stopping at the first Left
+func Sequence[E any, A any](es []Either[E, A]) Either[E, []A] {
+	return Traverse(es, func(e Either[E, A]) Either[E, A] { return e })
+}
+
+// TraverseMap applies f to every value of a map, keeping the keys.
+// Map iteration order is random, so which Left is returned is unspecified
+// when several values fail.
+func TraverseMap[K comparable, E any, A any, B any](xs map[K]A, f func(A) Either[E, B]) Either[E, map[K]B] {
+	out := make(map[K]B, len(xs))
+	for k, x := range xs {
+		e := f(x)
+		if e.isLeft {
+			return Left[E, map[K]B](e.left)
+		}
+		out[k] = e.right
+	}
+	return Right[E](out)
+}
+
+// SequenceMap turns a map of Eithers into an Either of a map
+func SequenceMap[K comparable, E any, A any](es map[K]Either[E, A]) Either[E, map[K]A] {
+	return TraverseMap(es, func(e Either[E, A]) Either[E, A] { return e })
+}
+
+// Partition splits Eithers into their Left and Right values, preserving order
+func Partition[E any, A any](es []Either[E, A]) ([]E, []A) {
+	var lefts []E
+	var rights []A
+	for _, e := range es {
+		if e.isLeft {
+			lefts = append(lefts, e.left)
+		} else {
+			rights = append(rights, e.right)
+		}
+	}
+	return lefts, rights
+}
+
+// Lefts returns the Left values, preserving order
+func Lefts[E any, A any](es []Either[E, A]) []E {
+	lefts, _ := Partition(es)
+	return lefts
+}
+
+// Rights returns the Right values, preserving order
+func Rights[E any, A any](es []Either[E, A]) []A {
+	_, rights := Partition(es)
+	return rights
+}
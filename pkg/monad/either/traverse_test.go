@@ -0,0 +1,68 @@
+package either
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func parseEither(s string) Either[string, int] {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return Left[string, int]("bad " + s)
+	}
+	return Right[string, int](n)
+}
+
+func TestTraverse(t *testing.T) {
+	all := Traverse([]string{"1", "2", "3"}, parseEither)
+	if !reflect.DeepEqual(all.GetRight(), []int{1, 2, 3}) {
+		t.Error("Traverse did not collect all Right values")
+	}
+
+	failed := Traverse([]string{"1", "x", "y"}, parseEither)
+	if failed.GetLeft() != "bad x" {
+		t.Error("Traverse should return the first Left")
+	}
+
+	seq := Sequence([]Either[string, int]{Right[string, int](1), Left[string, int]("e")})
+	if !seq.IsLeft() {
+		t.Error("Sequence with a Left should be Left")
+	}
+}
+
+func TestTraverseMap(t *testing.T) {
+	parsed := TraverseMap(map[string]string{"a": "1", "b": "2"}, parseEither)
+	if !reflect.DeepEqual(parsed.GetRight(), map[string]int{"a": 1, "b": 2}) {
+		t.Error("TraverseMap did not keep keys")
+	}
+
+	if !TraverseMap(map[string]string{"a": "1", "b": "x"}, parseEither).IsLeft() {
+		t.Error("TraverseMap with a failing value should be Left")
+	}
+
+	seq := SequenceMap(map[string]Either[string, int]{"a": Right[string, int](1)})
+	if seq.GetRight()["a"] != 1 {
+		t.Error("SequenceMap did not collect values")
+	}
+}
+
+func TestPartition(t *testing.T) {
+	es := []Either[string, int]{
+		Right[string, int](1),
+		Left[string, int]("a"),
+		Right[string, int](2),
+		Left[string, int]("b"),
+	}
+
+	lefts, rights := Partition(es)
+	if !reflect.DeepEqual(lefts, []string{"a", "b"}) {
+		t.Errorf("unexpected lefts: %v", lefts)
+	}
+	if !reflect.DeepEqual(rights, []int{1, 2}) {
+		t.Errorf("unexpected rights: %v", rights)
+	}
+	if !reflect.DeepEqual(Lefts(es), lefts) || !reflect.DeepEqual(Rights(es), rights) {
+		t.Error("Lefts/Rights disagree with Partition")
+	}
+}
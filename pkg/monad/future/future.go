@@ -0,0 +1,208 @@
+// Package future provides Future, the eventual result of a concurrent
+// computation, and Promise, its manually completed counterpart.
+//
+// A Future completes exactly once with an either.Either[error, A]. Producers
+// never block on delivering a result, so abandoning a Future leaks nothing.
+package future
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"sync"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+	"github.com/kpse/go-cat/pkg/monad/result"
+)
+
+// ErrZeroFuture is the error of a FlatMap whose continuation returned the zero Future
+var ErrZeroFuture = errors.New("future: continuation returned a zero Future")
+
+type state[A any] struct {
+	mu        sync.Mutex
+	done      chan struct{}
+	result    either.Either[error, A]
+	callbacks []func(either.Either[error, A])
+}
+
+func newState[A any]() *state[A] {
+	return &state[A]{done: make(chan struct{})}
+}
+
+func (s *state[A]) complete(r either.Either[error, A]) bool {
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+		return false
+	default:
+	}
+	s.result = r
+	close(s.done)
+	callbacks := s.callbacks
+	s.callbacks = nil
+	s.mu.Unlock()
+
+	for _, cb := range callbacks {
+		runCallback(cb, r)
+	}
+	return true
+}
+
+// runCallback runs cb and discards a panic, so that one failing callback
+// cannot stop the others or the Futures chained through them
+func runCallback[A any](cb func(either.Either[error, A]), r either.Either[error, A]) {
+	defer func() { _ = recover() }()
+	cb(r)
+}
+
+// Future is a read-only handle on a result that becomes available later.
+// Create one with Go, Successful, Failed or a Promise; the zero Future is not usable.
+type Future[A any] struct {
+	s *state[A]
+}
+
+// Promise is the write side of a Future
+type Promise[A any] struct {
+	s *state[A]
+}
+
+// NewPromise creates an uncompleted Promise
+func NewPromise[A any]() Promise[A] {
+	return Promise[A]{s: newState[A]()}
+}
+
+// Future returns the Future completed by this Promise
+func (p Promise[A]) Future() Future[A] {
+	return Future[A]{s: p.s}
+}
+
+// Complete sets the result. Only the first completion takes effect;
+// it reports whether this call completed the Promise.
+func (p Promise[A]) Complete(r either.Either[error, A]) bool {
+	return p.s.complete(r)
+}
+
+// Succeed completes the Promise with a value
+func (p Promise[A]) Succeed(a A) bool {
+	return p.Complete(either.Right[error](a))
+}
+
+// Fail completes the Promise with an error
+func (p Promise[A]) Fail(err error) bool {
+	return p.Complete(either.Left[error, A](err))
+}
+
+// Go runs f in a new goroutine and returns its Future.
+// A panic in f completes the Future with a *result.PanicError.
+func Go[A any](f func() (A, error)) Future[A] {
+	p := NewPromise[A]()
+	go func() {
+		p.Complete(result.TryCatch(f).Either)
+	}()
+	return p.Future()
+}
+
+// Successful returns an already completed Future holding a
+func Successful[A any](a A) Future[A] {
+	p := NewPromise[A]()
+	p.Succeed(a)
+	return p.Future()
+}
+
+// Failed returns an already completed Future holding err
+func Failed[A any](err error) Future[A] {
+	p := NewPromise[A]()
+	p.Fail(err)
+	return p.Future()
+}
+
+// Done returns a channel closed when the Future completes
+func (f Future[A]) Done() <-chan struct{} {
+	return f.s.done
+}
+
+// Await waits for the result, failing with ctx.Err() if the context is done first.
+// Giving up on a Future does not affect its producer.
+func (f Future[A]) Await(ctx context.Context) either.Either[error, A] {
+	select {
+	case <-f.s.done:
+		return f.s.result
+	default:
+	}
+	select {
+	case <-f.s.done:
+		return f.s.result
+	case <-ctx.Done():
+		return either.Left[error, A](ctx.Err())
+	}
+}
+
+// Poll returns the result if the Future has completed, without waiting
+func (f Future[A]) Poll() maybe.Maybe[either.Either[error, A]] {
+	select {
+	case <-f.s.done:
+		return maybe.Just(f.s.result)
+	default:
+		return maybe.Nothing[either.Either[error, A]]()
+	}
+}
+
+// Then registers a callback for the result. It runs immediately if the
+// Future has completed, otherwise on the goroutine that completes it.
+// A panic in cb is recovered and discarded.
+func (f Future[A]) Then(cb func(either.Either[error, A])) {
+	f.s.mu.Lock()
+	select {
+	case <-f.s.done:
+		f.s.mu.Unlock()
+		runCallback(cb, f.s.result)
+		return
+	default:
+	}
+	f.s.callbacks = append(f.s.callbacks, cb)
+	f.s.mu.Unlock()
+}
+
+// Map returns a Future holding fn applied to the value of f.
+// A panic in fn completes the new Future with a *result.PanicError.
+func Map[A any, B any](f Future[A], fn func(A) B) Future[B] {
+	return FlatMap(f, func(a A) Future[B] {
+		return Successful(fn(a))
+	})
+}
+
+// FlatMap returns a Future that continues with the Future produced by fn.
+// A panic in fn completes the new Future with a *result.PanicError, and a
+// zero Future returned by fn completes it with ErrZeroFuture.
+func FlatMap[A any, B any](f Future[A], fn func(A) Future[B]) Future[B] {
+	p := NewPromise[B]()
+	f.Then(func(r either.Either[error, A]) {
+		a, ok := r.RightValue()
+		if !ok {
+			p.Fail(r.GetLeft())
+			return
+		}
+		next, err := safeCall(fn, a)
+		if err != nil {
+			p.Fail(err)
+			return
+		}
+		if next.s == nil {
+			p.Fail(ErrZeroFuture)
+			return
+		}
+		next.Then(func(rb either.Either[error, B]) { p.Complete(rb) })
+	})
+	return p.Future()
+}
+
+func safeCall[A any, B any](fn func(A) Future[B], a A) (next Future[B], err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &result.PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+	return fn(a), nil
+}
@@ -0,0 +1,144 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/kpse/go-cat/pkg/monad/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuture(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Go and Await", func(t *testing.T) {
+		f := Go(func() (int, error) { return 42, nil })
+		assert.Equal(t, 42, f.Await(ctx).GetRight())
+
+		boom := errors.New("boom")
+		assert.Same(t, boom, Go(func() (int, error) { return 0, boom }).Await(ctx).GetLeft())
+	})
+
+	t.Run("panics become Left values", func(t *testing.T) {
+		f := Go(func() (int, error) { panic("producer failed") })
+		var pe *result.PanicError
+		require.ErrorAs(t, f.Await(ctx).GetLeft(), &pe)
+		assert.Equal(t, "producer failed", pe.Value)
+
+		mapped := Map(Successful(1), func(int) int { panic("mapper failed") })
+		require.ErrorAs(t, mapped.Await(ctx).GetLeft(), &pe)
+		assert.Equal(t, "mapper failed", pe.Value)
+	})
+
+	t.Run("Await honours the context", func(t *testing.T) {
+		p := NewPromise[int]()
+		cctx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, p.Future().Await(cctx).GetLeft(), context.DeadlineExceeded)
+
+		p.Succeed(1)
+		assert.Equal(t, 1, p.Future().Await(cctx).GetRight(), "completed results win over a done context")
+	})
+
+	t.Run("Promise completes once", func(t *testing.T) {
+		p := NewPromise[string]()
+		assert.True(t, p.Future().Poll().IsNothing())
+		assert.True(t, p.Succeed("first"))
+		assert.False(t, p.Fail(errors.New("late")))
+		assert.Equal(t, "first", p.Future().Poll().Get().GetRight())
+	})
+
+	t.Run("Map and FlatMap", func(t *testing.T) {
+		p := NewPromise[int]()
+		chained := FlatMap(Map(p.Future(), func(x int) int { return x * 2 }), func(x int) Future[string] {
+			return Go(func() (string, error) { return fmt.Sprintf("got %d", x), nil })
+		})
+		p.Succeed(3)
+		assert.Equal(t, "got 6", chained.Await(ctx).GetRight())
+
+		boom := errors.New("boom")
+		called := false
+		failed := Map(Failed[int](boom), func(x int) int { called = true; return x })
+		assert.Same(t, boom, failed.Await(ctx).GetLeft())
+		assert.False(t, called)
+	})
+
+	t.Run("Then runs callbacks before and after completion", func(t *testing.T) {
+		p := NewPromise[int]()
+		var mu sync.Mutex
+		var seen []int
+		record := func(r either.Either[error, int]) {
+			mu.Lock()
+			defer mu.Unlock()
+			seen = append(seen, r.GetRight())
+		}
+
+		p.Future().Then(record)
+		p.Succeed(7)
+		p.Future().Then(record)
+		assert.Equal(t, []int{7, 7}, seen)
+	})
+
+	t.Run("a panicking callback does not stop the others", func(t *testing.T) {
+		p := NewPromise[int]()
+		p.Future().Then(func(either.Either[error, int]) { panic("callback failed") })
+		mapped := Map(p.Future(), func(x int) int { return x + 1 })
+
+		p.Succeed(1)
+		actx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		assert.Equal(t, 2, mapped.Await(actx).GetRight())
+
+		assert.NotPanics(t, func() {
+			p.Future().Then(func(either.Either[error, int]) { panic("late callback failed") })
+		})
+	})
+
+	t.Run("a panicking callback on a Go future does not crash", func(t *testing.T) {
+		release := make(chan struct{})
+		f := Go(func() (int, error) { <-release; return 1, nil })
+		f.Then(func(either.Either[error, int]) { panic("callback failed") })
+		ran := make(chan struct{})
+		f.Then(func(either.Either[error, int]) { close(ran) })
+		close(release)
+
+		<-ran
+		assert.Equal(t, 1, f.Await(ctx).GetRight())
+	})
+
+	t.Run("FlatMap fails on a zero Future", func(t *testing.T) {
+		f := FlatMap(Successful(1), func(int) Future[int] { return Future[int]{} })
+		assert.ErrorIs(t, f.Await(ctx).GetLeft(), ErrZeroFuture)
+	})
+
+	t.Run("concurrent completion and awaiting", func(t *testing.T) {
+		p := NewPromise[int]()
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Add(2)
+			go func() { defer wg.Done(); p.Succeed(i) }()
+			go func() { defer wg.Done(); p.Future().Await(ctx) }()
+		}
+		wg.Wait()
+		assert.True(t, p.Future().Poll().IsJust())
+	})
+
+	t.Run("abandoned futures do not leak goroutines", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		for range 50 {
+			_ = Map(Go(func() (int, error) { return 1, nil }), func(x int) int { return x })
+		}
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+	})
+}
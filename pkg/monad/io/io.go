@@ -0,0 +1,52 @@
+// Package io provides IO and Task, descriptions of effects that run only
+// when Run is called with a context.
+//
+// An IO always produces a value. A Task may fail and produces an
+// either.Either[error, A]; Tasks honour context cancellation and deadlines
+// between steps and while waiting.
+package io
+
+import "context"
+
+// IO represents a deferred effect producing A
+type IO[A any] func(context.Context) A
+
+// Run executes the effect
+func (eff IO[A]) Run(ctx context.Context) A {
+	return eff(ctx)
+}
+
+// Of creates an IO that returns a without any effect
+func Of[A any](a A) IO[A] {
+	return func(context.Context) A {
+		return a
+	}
+}
+
+// Delay suspends a side-effecting function until the IO is run
+func Delay[A any](f func() A) IO[A] {
+	return func(context.Context) A {
+		return f()
+	}
+}
+
+// Defer postpones building an IO until it is run
+func Defer[A any](f func() IO[A]) IO[A] {
+	return func(ctx context.Context) A {
+		return f()(ctx)
+	}
+}
+
+// Map applies a function to the result of an IO
+func Map[A any, B any](eff IO[A], f func(A) B) IO[B] {
+	return func(ctx context.Context) B {
+		return f(eff(ctx))
+	}
+}
+
+// Bind sequences two effects, feeding the result of the first to f
+func Bind[A any, B any](eff IO[A], f func(A) IO[B]) IO[B] {
+	return func(ctx context.Context) B {
+		return f(eff(ctx))(ctx)
+	}
+}
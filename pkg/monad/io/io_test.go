@@ -0,0 +1,35 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIO(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Delay runs only when the IO is run", func(t *testing.T) {
+		calls := 0
+		eff := Delay(func() int { calls++; return calls })
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, 1, eff.Run(ctx))
+		assert.Equal(t, 2, eff.Run(ctx))
+	})
+
+	t.Run("Defer builds the IO on each run", func(t *testing.T) {
+		built := 0
+		eff := Defer(func() IO[int] { built++; return Of(built) })
+		assert.Equal(t, 0, built)
+		assert.Equal(t, 1, eff.Run(ctx))
+	})
+
+	t.Run("Map and Bind", func(t *testing.T) {
+		eff := Bind(Of(2), func(x int) IO[string] {
+			return Map(Of(x*10), func(y int) string { return fmt.Sprintf("n=%d", y) })
+		})
+		assert.Equal(t, "n=20", eff.Run(ctx))
+	})
+}
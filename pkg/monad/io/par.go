@@ -0,0 +1,178 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// ErrNoTasks is returned by Race when it is given no tasks
+var ErrNoTasks = errors.New("io: no tasks to race")
+
+// failFast records the first failure of a group and cancels its siblings
+type failFast struct {
+	once   sync.Once
+	err    error
+	cancel context.CancelFunc
+}
+
+func (f *failFast) fail(err error) {
+	f.once.Do(func() {
+		f.err = err
+		f.cancel()
+	})
+}
+
+// ParTraverse runs f for every element concurrently, with at most limit
+// Tasks in flight (no limit if limit <= 0). Results keep the input order.
+// The first failure cancels the remaining Tasks and is returned once every
+// started goroutine has finished.
+func ParTraverse[A any, B any](xs []A, limit int, f func(A) Task[B]) Task[[]B] {
+	return func(parent context.Context) either.Either[error, []B] {
+		ctx, cancel := context.WithCancel(parent)
+		defer cancel()
+		group := &failFast{cancel: cancel}
+
+		var sem chan struct{}
+		if limit > 0 {
+			sem = make(chan struct{}, limit)
+		}
+
+		out := make([]B, len(xs))
+		var wg sync.WaitGroup
+	launch:
+		for i, x := range xs {
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					break launch
+				}
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if sem != nil {
+					defer func() { <-sem }()
+				}
+				r := f(x).Run(ctx)
+				if err, failed := r.LeftValue(); failed {
+					group.fail(err)
+					return
+				}
+				out[i] = r.GetRight()
+			}()
+		}
+		wg.Wait()
+
+		if group.err != nil {
+			return either.Left[error, []B](group.err)
+		}
+		if err := parent.Err(); err != nil {
+			return either.Left[error, []B](err)
+		}
+		return either.Right[error](out)
+	}
+}
+
+// ParSequence runs the Tasks concurrently with at most limit in flight, as ParTraverse
+func ParSequence[A any](limit int, tasks []Task[A]) Task[[]A] {
+	return ParTraverse(tasks, limit, func(t Task[A]) Task[A] { return t })
+}
+
+// ParZip runs two Tasks concurrently and pairs their results.
+// If either fails the other is cancelled and the first failure is returned.
+func ParZip[A any, B any](ta Task[A], tb Task[B]) Task[tuple.Pair[A, B]] {
+	return func(parent context.Context) either.Either[error, tuple.Pair[A, B]] {
+		ctx, cancel := context.WithCancel(parent)
+		defer cancel()
+		group := &failFast{cancel: cancel}
+
+		var ra either.Either[error, A]
+		var rb either.Either[error, B]
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			ra = ta.Run(ctx)
+			if err, failed := ra.LeftValue(); failed {
+				group.fail(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			rb = tb.Run(ctx)
+			if err, failed := rb.LeftValue(); failed {
+				group.fail(err)
+			}
+		}()
+		wg.Wait()
+
+		if group.err != nil {
+			return either.Left[error, tuple.Pair[A, B]](group.err)
+		}
+		return either.Zip(ra, rb)
+	}
+}
+
+// Race runs the Tasks concurrently and returns the first success,
+// cancelling the rest. If every Task fails the errors are joined.
+// Race returns only after all Tasks have returned, so none outlive it.
+func Race[A any](tasks ...Task[A]) Task[A] {
+	return func(parent context.Context) either.Either[error, A] {
+		if len(tasks) == 0 {
+			return either.Left[error, A](ErrNoTasks)
+		}
+		ctx, cancel := context.WithCancel(parent)
+		defer cancel()
+
+		results := make(chan either.Either[error, A], len(tasks))
+		for _, t := range tasks {
+			go func() {
+				results <- t.Run(ctx)
+			}()
+		}
+
+		var winner either.Either[error, A]
+		won := false
+		var errs []error
+		for range tasks {
+			r := <-results
+			if err, failed := r.LeftValue(); failed {
+				errs = append(errs, err)
+				continue
+			}
+			if !won {
+				winner, won = r, true
+				cancel()
+			}
+		}
+
+		if won {
+			return winner
+		}
+		return either.Left[error, A](errors.Join(errs...))
+	}
+}
+
+// AllSettled runs the Tasks concurrently and waits for all of them,
+// returning every outcome in input order. It never fails; cancellation
+// shows up as Left values in the Tasks that observed it.
+func AllSettled[A any](tasks ...Task[A]) IO[[]either.Either[error, A]] {
+	return func(ctx context.Context) []either.Either[error, A] {
+		out := make([]either.Either[error, A], len(tasks))
+		var wg sync.WaitGroup
+		for i, t := range tasks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out[i] = t.Run(ctx)
+			}()
+		}
+		wg.Wait()
+		return out
+	}
+}
@@ -0,0 +1,142 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitFor blocks until the context is done, tracking how many are still running
+func waitFor[A any](running *atomic.Int32) Task[A] {
+	return FromFunc(func(ctx context.Context) (A, error) {
+		running.Add(1)
+		defer running.Add(-1)
+		<-ctx.Done()
+		var zero A
+		return zero, ctx.Err()
+	})
+}
+
+func after[A any](d time.Duration, a A) Task[A] {
+	return BindTask(Sleep(d), func(struct{}) Task[A] { return Succeed(a) })
+}
+
+func TestParTraverse(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps input order", func(t *testing.T) {
+		task := ParTraverse([]int{30, 10, 20}, 0, func(ms int) Task[int] {
+			return after(time.Duration(ms)*time.Millisecond, ms)
+		})
+		assert.Equal(t, []int{30, 10, 20}, task.Run(ctx).GetRight())
+	})
+
+	t.Run("respects the concurrency limit", func(t *testing.T) {
+		var inFlight, peak atomic.Int32
+		task := ParTraverse(make([]int, 20), 3, func(int) Task[int] {
+			return FromFunc(func(context.Context) (int, error) {
+				n := inFlight.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(2 * time.Millisecond)
+				inFlight.Add(-1)
+				return 0, nil
+			})
+		})
+
+		require.True(t, task.Run(ctx).IsRight())
+		assert.LessOrEqual(t, peak.Load(), int32(3))
+	})
+
+	t.Run("first failure cancels the rest", func(t *testing.T) {
+		boom := errors.New("boom")
+		var running atomic.Int32
+		task := ParTraverse([]int{0, 1, 2}, 0, func(i int) Task[int] {
+			if i == 1 {
+				return Fail[int](boom)
+			}
+			return waitFor[int](&running)
+		})
+
+		assert.Same(t, boom, task.Run(ctx).GetLeft())
+		assert.Zero(t, running.Load())
+	})
+
+	t.Run("parent cancellation", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		task := ParSequence(1, []Task[int]{Succeed(1), Succeed(2)})
+		assert.ErrorIs(t, task.Run(cctx).GetLeft(), context.Canceled)
+	})
+}
+
+func TestParZip(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, tuple.NewPair(1, "a"), ParZip(after(5*time.Millisecond, 1), Succeed("a")).Run(ctx).GetRight())
+
+	boom := errors.New("boom")
+	var running atomic.Int32
+	failed := ParZip(waitFor[int](&running), Fail[string](boom)).Run(ctx)
+	assert.Same(t, boom, failed.GetLeft())
+	assert.Zero(t, running.Load())
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first success wins and losers are cancelled", func(t *testing.T) {
+		var running atomic.Int32
+		r := Race(waitFor[string](&running), after(5*time.Millisecond, "fast"), waitFor[string](&running)).Run(ctx)
+		assert.Equal(t, "fast", r.GetRight())
+		assert.Zero(t, running.Load())
+	})
+
+	t.Run("failures do not win", func(t *testing.T) {
+		r := Race(Fail[int](errors.New("quick failure")), after(5*time.Millisecond, 2)).Run(ctx)
+		assert.Equal(t, 2, r.GetRight())
+	})
+
+	t.Run("all failures are joined", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		err := Race(Fail[int](errA), Fail[int](errB)).Run(ctx).GetLeft()
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errB)
+		assert.ErrorIs(t, Race[int]().Run(ctx).GetLeft(), ErrNoTasks)
+	})
+}
+
+func TestAllSettled(t *testing.T) {
+	boom := errors.New("boom")
+	results := AllSettled(Succeed(1), Fail[int](boom), after(time.Millisecond, 3)).Run(context.Background())
+
+	require.Len(t, results, 3)
+	assert.Equal(t, 1, results[0].GetRight())
+	assert.Same(t, boom, results[1].GetLeft())
+	assert.Equal(t, 3, results[2].GetRight())
+}
+
+func TestParNoLeaks(t *testing.T) {
+	before := runtime.NumGoroutine()
+	var running atomic.Int32
+	for range 10 {
+		Race(waitFor[int](&running), Succeed(1)).Run(context.Background())
+		ParTraverse([]int{1, 2, 3}, 2, func(i int) Task[int] {
+			if i == 2 {
+				return Fail[int](errors.New("x"))
+			}
+			return waitFor[int](&running)
+		}).Run(context.Background())
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
@@ -0,0 +1,111 @@
+package io
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// ReleaseFunc frees a resource. It receives a context that is never
+// cancelled, so cleanup still runs after the caller's context is done.
+type ReleaseFunc func(context.Context) error
+
+// Bracket acquires a resource, uses it and releases it. Once acquire has
+// succeeded, release always runs: after success, failure, cancellation, or
+// a panic in use, which is re-raised after releasing. A release error is
+// joined with any error from use.
+func Bracket[R any, A any](acquire Task[R], use func(R) Task[A], release func(context.Context, R) error) Task[A] {
+	return Use(Make(acquire, release), use)
+}
+
+// Resource describes how to acquire a value and release everything acquired
+// along the way. Nothing happens until it is passed to Use.
+type Resource[A any] struct {
+	acquire func(context.Context) (A, ReleaseFunc, error)
+}
+
+func noRelease(context.Context) error { return nil }
+
+// Make creates a Resource from an acquiring Task and its release function
+func Make[A any](acquire Task[A], release func(context.Context, A) error) Resource[A] {
+	return Resource[A]{acquire: func(ctx context.Context) (A, ReleaseFunc, error) {
+		r := acquire.Run(ctx)
+		if err, failed := r.LeftValue(); failed {
+			var zero A
+			return zero, noRelease, err
+		}
+		a := r.GetRight()
+		return a, func(ctx context.Context) error { return release(ctx, a) }, nil
+	}}
+}
+
+// Ready creates a Resource holding a with nothing to release
+func Ready[A any](a A) Resource[A] {
+	return Resource[A]{acquire: func(context.Context) (A, ReleaseFunc, error) {
+		return a, noRelease, nil
+	}}
+}
+
+// MapResource applies a function to the value of a Resource
+func MapResource[A any, B any](r Resource[A], f func(A) B) Resource[B] {
+	return BindResource(r, func(a A) Resource[B] { return Ready(f(a)) })
+}
+
+// BindResource acquires r, then the Resource built from its value. The
+// combined Resource releases in LIFO order: the inner one before r. If the
+// inner acquisition fails or panics, r is released immediately.
+func BindResource[A any, B any](r Resource[A], f func(A) Resource[B]) Resource[B] {
+	return Resource[B]{acquire: func(ctx context.Context) (b B, release ReleaseFunc, err error) {
+		a, releaseA, err := r.acquire(ctx)
+		if err != nil {
+			var zero B
+			return zero, noRelease, err
+		}
+
+		acquired := false
+		defer func() {
+			if !acquired {
+				// f panicked or its acquisition failed: undo r before returning
+				if relErr := releaseA(context.WithoutCancel(ctx)); relErr != nil {
+					err = errors.Join(err, relErr)
+				}
+			}
+		}()
+
+		b, releaseB, err := f(a).acquire(ctx)
+		if err != nil {
+			return b, noRelease, err
+		}
+		acquired = true
+		return b, func(ctx context.Context) error {
+			return errors.Join(releaseB(ctx), releaseA(ctx))
+		}, nil
+	}}
+}
+
+// Use acquires the Resource, runs f with its value and releases it,
+// with the guarantees described on Bracket
+func Use[A any, B any](r Resource[A], f func(A) Task[B]) Task[B] {
+	return func(ctx context.Context) (result either.Either[error, B]) {
+		if err := ctx.Err(); err != nil {
+			return either.Left[error, B](err)
+		}
+		a, release, err := r.acquire(ctx)
+		if err != nil {
+			return either.Left[error, B](err)
+		}
+
+		defer func() {
+			relErr := release(context.WithoutCancel(ctx))
+			if relErr == nil {
+				return
+			}
+			if useErr, failed := result.LeftValue(); failed {
+				relErr = errors.Join(useErr, relErr)
+			}
+			result = either.Left[error, B](relErr)
+		}()
+		return f(a).Run(ctx)
+	}
+}
@@ -0,0 +1,143 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tracker records acquisitions and releases in order
+type tracker struct {
+	events []string
+}
+
+func (tr *tracker) resource(name string, releaseErr error) Resource[string] {
+	acquire := FromFunc(func(context.Context) (string, error) {
+		tr.events = append(tr.events, "acquire "+name)
+		return name, nil
+	})
+	return Make(acquire, func(_ context.Context, n string) error {
+		tr.events = append(tr.events, "release "+n)
+		return releaseErr
+	})
+}
+
+func TestBracket(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("releases after success", func(t *testing.T) {
+		tr := &tracker{}
+		task := Bracket(Succeed("file"), func(f string) Task[int] {
+			tr.events = append(tr.events, "use "+f)
+			return Succeed(len(f))
+		}, func(_ context.Context, f string) error {
+			tr.events = append(tr.events, "release "+f)
+			return nil
+		})
+
+		assert.Equal(t, 4, task.Run(ctx).GetRight())
+		assert.Equal(t, []string{"use file", "release file"}, tr.events)
+	})
+
+	t.Run("joins use and release errors", func(t *testing.T) {
+		useErr, relErr := errors.New("use"), errors.New("release")
+		task := Bracket(Succeed(1), func(int) Task[int] { return Fail[int](useErr) }, func(context.Context, int) error { return relErr })
+
+		err := task.Run(ctx).GetLeft()
+		assert.ErrorIs(t, err, useErr)
+		assert.ErrorIs(t, err, relErr)
+	})
+
+	t.Run("does not release a failed acquisition", func(t *testing.T) {
+		released := false
+		acqErr := errors.New("acquire")
+		task := Bracket(Fail[int](acqErr), func(int) Task[int] { return Succeed(1) }, func(context.Context, int) error {
+			released = true
+			return nil
+		})
+
+		assert.Same(t, acqErr, task.Run(ctx).GetLeft())
+		assert.False(t, released)
+	})
+
+	t.Run("releases on panic and re-panics", func(t *testing.T) {
+		tr := &tracker{}
+		task := Use(tr.resource("conn", nil), func(string) Task[int] { panic("use failed") })
+
+		assert.PanicsWithValue(t, "use failed", func() { task.Run(ctx) })
+		assert.Equal(t, []string{"acquire conn", "release conn"}, tr.events)
+	})
+
+	t.Run("releases with a live context after cancellation", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		var releaseCtxErr error
+		task := Bracket(Succeed(1), func(int) Task[int] {
+			cancel()
+			return Fail[int](cctx.Err())
+		}, func(rctx context.Context, _ int) error {
+			releaseCtxErr = rctx.Err()
+			return nil
+		})
+
+		assert.ErrorIs(t, task.Run(cctx).GetLeft(), context.Canceled)
+		assert.NoError(t, releaseCtxErr)
+	})
+}
+
+func TestResource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("releases in LIFO order", func(t *testing.T) {
+		tr := &tracker{}
+		both := BindResource(tr.resource("file", nil), func(f string) Resource[string] {
+			return MapResource(tr.resource("conn", nil), func(c string) string { return f + "+" + c })
+		})
+
+		r := Use(both, func(v string) Task[string] {
+			tr.events = append(tr.events, "use "+v)
+			return Succeed(v)
+		}).Run(ctx)
+
+		assert.Equal(t, "file+conn", r.GetRight())
+		assert.Equal(t, []string{"acquire file", "acquire conn", "use file+conn", "release conn", "release file"}, tr.events)
+	})
+
+	t.Run("failed inner acquisition releases the outer resource", func(t *testing.T) {
+		tr := &tracker{}
+		acqErr := errors.New("dial failed")
+		both := BindResource(tr.resource("file", nil), func(string) Resource[string] {
+			return Make(Fail[string](acqErr), func(context.Context, string) error { return nil })
+		})
+
+		used := false
+		r := Use(both, func(string) Task[int] { used = true; return Succeed(1) }).Run(ctx)
+		assert.Same(t, acqErr, r.GetLeft())
+		assert.False(t, used)
+		assert.Equal(t, []string{"acquire file", "release file"}, tr.events)
+	})
+
+	t.Run("all releases run even if one fails", func(t *testing.T) {
+		tr := &tracker{}
+		relErr := errors.New("close failed")
+		both := BindResource(tr.resource("file", nil), func(string) Resource[string] {
+			return tr.resource("conn", relErr)
+		})
+
+		r := Use(both, func(string) Task[int] { return Succeed(1) }).Run(ctx)
+		require.True(t, r.IsLeft())
+		assert.ErrorIs(t, r.GetLeft(), relErr)
+		assert.Equal(t, []string{"acquire file", "acquire conn", "release conn", "release file"}, tr.events)
+	})
+
+	t.Run("nothing is acquired on a cancelled context", func(t *testing.T) {
+		tr := &tracker{}
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		r := Use(tr.resource("file", nil), func(string) Task[int] { return Succeed(1) }).Run(cctx)
+		assert.ErrorIs(t, r.GetLeft(), context.Canceled)
+		assert.Empty(t, tr.events)
+	})
+}
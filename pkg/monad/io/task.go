@@ -0,0 +1,112 @@
+package io
+
+import (
+	"context"
+	"time"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// Task represents a deferred effect that may fail
+type Task[A any] func(context.Context) either.Either[error, A]
+
+// Run executes the Task, failing with ctx.Err() without starting it if the
+// context is already done
+func (t Task[A]) Run(ctx context.Context) either.Either[error, A] {
+	if err := ctx.Err(); err != nil {
+		return either.Left[error, A](err)
+	}
+	return t(ctx)
+}
+
+// Succeed creates a Task that returns a
+func Succeed[A any](a A) Task[A] {
+	return func(context.Context) either.Either[error, A] {
+		return either.Right[error](a)
+	}
+}
+
+// Fail creates a Task that fails with err
+func Fail[A any](err error) Task[A] {
+	return func(context.Context) either.Either[error, A] {
+		return either.Left[error, A](err)
+	}
+}
+
+// FromFunc creates a Task from a context-aware function in Go's (A, error) convention
+func FromFunc[A any](f func(context.Context) (A, error)) Task[A] {
+	return func(ctx context.Context) either.Either[error, A] {
+		a, err := f(ctx)
+		if err != nil {
+			return either.Left[error, A](err)
+		}
+		return either.Right[error](a)
+	}
+}
+
+// FromIO lifts an IO that cannot fail into a Task
+func FromIO[A any](eff IO[A]) Task[A] {
+	return func(ctx context.Context) either.Either[error, A] {
+		return either.Right[error](eff(ctx))
+	}
+}
+
+// DeferTask postpones building a Task until it is run
+func DeferTask[A any](f func() Task[A]) Task[A] {
+	return func(ctx context.Context) either.Either[error, A] {
+		return f().Run(ctx)
+	}
+}
+
+// MapTask applies a function to the result of a successful Task
+func MapTask[A any, B any](t Task[A], f func(A) B) Task[B] {
+	return func(ctx context.Context) either.Either[error, B] {
+		return either.Map(t.Run(ctx), f)
+	}
+}
+
+// BindTask sequences two Tasks, stopping at the first failure or when the
+// context is done before the second starts
+func BindTask[A any, B any](t Task[A], f func(A) Task[B]) Task[B] {
+	return func(ctx context.Context) either.Either[error, B] {
+		return either.Bind(t.Run(ctx), func(a A) either.Either[error, B] {
+			return f(a).Run(ctx)
+		})
+	}
+}
+
+// Sleep creates a Task that waits for d, failing early if the context is done
+func Sleep(d time.Duration) Task[struct{}] {
+	return func(ctx context.Context) either.Either[error, struct{}] {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return either.Right[error](struct{}{})
+		case <-ctx.Done():
+			return either.Left[error, struct{}](ctx.Err())
+		}
+	}
+}
+
+// Timeout runs t with a deadline of d. If t does not return in time the
+// Task fails with context.DeadlineExceeded; t keeps running in the
+// background until it observes its cancelled context.
+func Timeout[A any](t Task[A], d time.Duration) Task[A] {
+	return func(ctx context.Context) either.Either[error, A] {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		done := make(chan either.Either[error, A], 1)
+		go func() {
+			done <- t.Run(ctx)
+		}()
+
+		select {
+		case result := <-done:
+			return result
+		case <-ctx.Done():
+			return either.Left[error, A](ctx.Err())
+		}
+	}
+}
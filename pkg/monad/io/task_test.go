@@ -0,0 +1,87 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTask(t *testing.T) {
+	ctx := context.Background()
+	parse := func(s string) Task[int] {
+		return FromFunc(func(context.Context) (int, error) { return strconv.Atoi(s) })
+	}
+
+	t.Run("FromFunc and Run", func(t *testing.T) {
+		assert.Equal(t, 42, parse("42").Run(ctx).GetRight())
+		assert.Error(t, parse("x").Run(ctx).GetLeft())
+	})
+
+	t.Run("nothing runs until Run", func(t *testing.T) {
+		ran := false
+		task := FromIO(Delay(func() bool { ran = true; return true }))
+		assert.False(t, ran)
+		assert.True(t, task.Run(ctx).GetRight())
+		assert.True(t, ran)
+	})
+
+	t.Run("MapTask and BindTask", func(t *testing.T) {
+		doubled := MapTask(parse("21"), func(x int) int { return x * 2 })
+		assert.Equal(t, 42, doubled.Run(ctx).GetRight())
+
+		chained := BindTask(Succeed("7"), parse)
+		assert.Equal(t, 7, chained.Run(ctx).GetRight())
+
+		boom := errors.New("boom")
+		failed := BindTask(Fail[string](boom), parse)
+		assert.Same(t, boom, failed.Run(ctx).GetLeft())
+	})
+
+	t.Run("DeferTask", func(t *testing.T) {
+		built := false
+		task := DeferTask(func() Task[int] { built = true; return Succeed(1) })
+		assert.False(t, built)
+		assert.Equal(t, 1, task.Run(ctx).GetRight())
+	})
+
+	t.Run("cancelled context stops the pipeline", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		ran := false
+		pipeline := BindTask(FromFunc(func(context.Context) (int, error) {
+			cancel()
+			return 1, nil
+		}), func(int) Task[int] {
+			return FromIO(Delay(func() int { ran = true; return 2 }))
+		})
+
+		assert.ErrorIs(t, pipeline.Run(cctx).GetLeft(), context.Canceled)
+		assert.False(t, ran)
+		assert.ErrorIs(t, Succeed(1).Run(cctx).GetLeft(), context.Canceled)
+	})
+
+	t.Run("Sleep honours cancellation", func(t *testing.T) {
+		cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, Sleep(time.Hour).Run(cctx).GetLeft(), context.DeadlineExceeded)
+		assert.True(t, Sleep(time.Millisecond).Run(ctx).IsRight())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		stuck := FromFunc(func(context.Context) (int, error) {
+			time.Sleep(100 * time.Millisecond)
+			return 1, nil
+		})
+		assert.ErrorIs(t, Timeout(stuck, 5*time.Millisecond).Run(ctx).GetLeft(), context.DeadlineExceeded)
+		assert.Equal(t, 3, Timeout(Succeed(3), time.Second).Run(ctx).GetRight())
+
+		deadline := FromFunc(func(ctx context.Context) (bool, error) {
+			_, ok := ctx.Deadline()
+			return ok, nil
+		})
+		assert.True(t, Timeout(deadline, time.Second).Run(ctx).GetRight())
+	})
+}
@@ -0,0 +1,63 @@
+// Package lazy provides Lazy, a value computed on first use and memoized.
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+// Lazy is a memoized thunk. The computation runs at most once, on the first
+// Force, even when Force is called from several goroutines. If it panics,
+// every Force re-panics with the same value.
+type Lazy[A any] struct {
+	force  func() A
+	forced atomic.Bool
+}
+
+// New creates a Lazy that computes its value with f on first use
+func New[A any](f func() A) *Lazy[A] {
+	l := &Lazy[A]{}
+	l.force = sync.OnceValue(func() A {
+		defer l.forced.Store(true)
+		return f()
+	})
+	return l
+}
+
+// Of creates an already evaluated Lazy
+func Of[A any](a A) *Lazy[A] {
+	l := &Lazy[A]{force: func() A { return a }}
+	l.forced.Store(true)
+	return l
+}
+
+// Force returns the value, computing it if this is the first call
+func (l *Lazy[A]) Force() A {
+	return l.force()
+}
+
+// IsForced reports whether the value has been computed
+func (l *Lazy[A]) IsForced() bool {
+	return l.forced.Load()
+}
+
+// Map returns a Lazy that applies f to the value of l when it is forced
+func Map[A any, B any](l *Lazy[A], f func(A) B) *Lazy[B] {
+	return New(func() B {
+		return f(l.Force())
+	})
+}
+
+// FlatMap returns a Lazy that forces the Lazy produced by f when it is forced
+func FlatMap[A any, B any](l *Lazy[A], f func(A) *Lazy[B]) *Lazy[B] {
+	return New(func() B {
+		return f(l.Force()).Force()
+	})
+}
+
+// GetOrElse returns the value of m, forcing d only when m is Nothing
+func GetOrElse[A any](m maybe.Maybe[A], d *Lazy[A]) A {
+	return m.GetOrElseFunc(d.Force)
+}
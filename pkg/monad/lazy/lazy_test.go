@@ -0,0 +1,76 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("computes once on first Force", func(t *testing.T) {
+		calls := 0
+		l := New(func() int { calls++; return 42 })
+		assert.False(t, l.IsForced())
+		assert.Equal(t, 0, calls)
+
+		assert.Equal(t, 42, l.Force())
+		assert.Equal(t, 42, l.Force())
+		assert.Equal(t, 1, calls)
+		assert.True(t, l.IsForced())
+	})
+
+	t.Run("concurrent Force computes once", func(t *testing.T) {
+		var calls atomic.Int32
+		l := New(func() int { calls.Add(1); return 7 })
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, 7, l.Force())
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("panics are replayed", func(t *testing.T) {
+		calls := 0
+		l := New(func() int { calls++; panic("config missing") })
+		assert.PanicsWithValue(t, "config missing", func() { l.Force() })
+		assert.PanicsWithValue(t, "config missing", func() { l.Force() })
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Map and FlatMap stay lazy", func(t *testing.T) {
+		calls := 0
+		base := New(func() int { calls++; return 2 })
+		doubled := Map(base, func(x int) int { return x * 2 })
+		chained := FlatMap(doubled, func(x int) *Lazy[string] {
+			return Of(string(rune('a' + x)))
+		})
+		assert.Equal(t, 0, calls)
+
+		assert.Equal(t, "e", chained.Force())
+		assert.Equal(t, 4, doubled.Force())
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Of is already forced", func(t *testing.T) {
+		assert.True(t, Of(1).IsForced())
+		assert.Equal(t, 1, Of(1).Force())
+	})
+
+	t.Run("GetOrElse only forces the default on Nothing", func(t *testing.T) {
+		expensive := New(func() string { return "computed default" })
+
+		assert.Equal(t, "set", GetOrElse(maybe.Just("set"), expensive))
+		assert.False(t, expensive.IsForced())
+		assert.Equal(t, "computed default", GetOrElse(maybe.Nothing[string](), expensive))
+		assert.True(t, expensive.IsForced())
+	})
+}
@@ -0,0 +1,42 @@
+package maybe
+
+// GetOrElseFunc returns the value of a Just, or calls f for a default.
+// Unlike GetOrElse the default is only computed when it is needed.
+func (m Maybe[T]) GetOrElseFunc(f func() T) T {
+	if m.value == nil {
+		return f()
+	}
+	return *m.value
+}
+
+// OrElse returns m if it is a Just, otherwise the result of calling f
+func OrElse[T any](m Maybe[T], f func() Maybe[T]) Maybe[T] {
+	if m.value == nil {
+		return f()
+	}
+	return m
+}
+
+// Or returns the first Just among its arguments, or Nothing
+func Or[T any](first Maybe[T], rest ...Maybe[T]) Maybe[T] {
+	if first.value != nil {
+		return first
+	}
+	for _, m := range rest {
+		if m.value != nil {
+			return m
+		}
+	}
+	return Nothing[T]()
+}
+
+// FirstJust calls each lookup in order and returns the first Just,
+// without calling the lookups after it
+func FirstJust[T any](lookups ...func() Maybe[T]) Maybe[T] {
+	for _, lookup := range lookups {
+		if m := lookup(); m.value != nil {
+			return m
+		}
+	}
+	return Nothing[T]()
+}
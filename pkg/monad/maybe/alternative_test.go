@@ -0,0 +1,52 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlternative(t *testing.T) {
+	t.Run("GetOrElseFunc is lazy", func(t *testing.T) {
+		called := false
+		fallback := func() int { called = true; return 10 }
+
+		assert.Equal(t, 5, Just(5).GetOrElseFunc(fallback))
+		assert.False(t, called)
+		assert.Equal(t, 10, Nothing[int]().GetOrElseFunc(fallback))
+		assert.True(t, called)
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		called := false
+		fallback := func() Maybe[int] { called = true; return Just(10) }
+
+		assert.Equal(t, 5, OrElse(Just(5), fallback).Get())
+		assert.False(t, called)
+		assert.Equal(t, 10, OrElse(Nothing[int](), fallback).Get())
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		assert.Equal(t, 2, Or(Nothing[int](), Just(2), Just(3)).Get())
+		assert.True(t, Or(Nothing[int]()).IsNothing())
+	})
+
+	t.Run("FirstJust resolves env, file, default", func(t *testing.T) {
+		var tried []string
+		lookup := func(name string, m Maybe[string]) func() Maybe[string] {
+			return func() Maybe[string] {
+				tried = append(tried, name)
+				return m
+			}
+		}
+
+		v := FirstJust(
+			lookup("env", Nothing[string]()),
+			lookup("file", Just("from-file")),
+			lookup("default", Just("default")),
+		)
+		assert.Equal(t, "from-file", v.Get())
+		assert.Equal(t, []string{"env", "file"}, tried)
+		assert.True(t, FirstJust[int]().IsNothing())
+	})
+}
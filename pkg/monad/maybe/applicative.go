@@ -0,0 +1,58 @@
+package maybe
+
+import "github.com/kpse/go-cat/pkg/data/tuple"
+
+// Pure lifts a value into Maybe; it is the same as Just
+func Pure[T any](x T) Maybe[T] {
+	return Just(x)
+}
+
+// Ap applies a Maybe function to a Maybe value
+func Ap[A, B any](mf Maybe[func(A) B], ma Maybe[A]) Maybe[B] {
+	if mf.value == nil || ma.value == nil {
+		return Nothing[B]()
+	}
+	return Just((*mf.value)(*ma.value))
+}
+
+// Zip pairs two Maybes, or returns Nothing if either is Nothing
+func Zip[A, B any](ma Maybe[A], mb Maybe[B]) Maybe[tuple.Pair[A, B]] {
+	return Lift2(ma, mb, tuple.NewPair[A, B])
+}
+
+// Zip3 combines three Maybes into a Triple
+func Zip3[A, B, C any](ma Maybe[A], mb Maybe[B], mc Maybe[C]) Maybe[tuple.Triple[A, B, C]] {
+	return Lift3(ma, mb, mc, tuple.NewTriple[A, B, C])
+}
+
+// Lift2 applies f to the values of two Maybes when both are Just
+func Lift2[A, B, R any](ma Maybe[A], mb Maybe[B], f func(A, B) R) Maybe[R] {
+	if ma.value == nil || mb.value == nil {
+		return Nothing[R]()
+	}
+	return Just(f(*ma.value, *mb.value))
+}
+
+// Lift3 applies f to the values of three Maybes when all are Just
+func Lift3[A, B, C, R any](ma Maybe[A], mb Maybe[B], mc Maybe[C], f func(A, B, C) R) Maybe[R] {
+	if ma.value == nil || mb.value == nil || mc.value == nil {
+		return Nothing[R]()
+	}
+	return Just(f(*ma.value, *mb.value, *mc.value))
+}
+
+// Lift4 applies f to the values of four Maybes when all are Just
+func Lift4[A, B, C, D, R any](ma Maybe[A], mb Maybe[B], mc Maybe[C], md Maybe[D], f func(A, B, C, D) R) Maybe[R] {
+	if ma.value == nil || mb.value == nil || mc.value == nil || md.value == nil {
+		return Nothing[R]()
+	}
+	return Just(f(*ma.value, *mb.value, *mc.value, *md.value))
+}
+
+// Lift5 applies f to the values of five Maybes when all are Just
+func Lift5[A, B, C, D, E, R any](ma Maybe[A], mb Maybe[B], mc Maybe[C], md Maybe[D], me Maybe[E], f func(A, B, C, D, E) R) Maybe[R] {
+	if ma.value == nil || mb.value == nil || mc.value == nil || md.value == nil || me.value == nil {
+		return Nothing[R]()
+	}
+	return Just(f(*ma.value, *mb.value, *mc.value, *md.value, *me.value))
+}
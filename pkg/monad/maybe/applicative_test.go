@@ -0,0 +1,55 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/data/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicative(t *testing.T) {
+	t.Run("Pure and Ap", func(t *testing.T) {
+		inc := Pure(func(x int) int { return x + 1 })
+		assert.Equal(t, 6, Ap(inc, Just(5)).Get())
+		assert.True(t, Ap(inc, Nothing[int]()).IsNothing())
+		assert.True(t, Ap(Nothing[func(int) int](), Just(5)).IsNothing())
+	})
+
+	t.Run("Zip and Zip3", func(t *testing.T) {
+		assert.Equal(t, tuple.NewPair(1, "a"), Zip(Just(1), Just("a")).Get())
+		assert.True(t, Zip(Just(1), Nothing[string]()).IsNothing())
+		assert.Equal(t, tuple.NewTriple(1, "a", true), Zip3(Just(1), Just("a"), Just(true)).Get())
+	})
+
+	t.Run("Lift5 builds a struct from five lookups", func(t *testing.T) {
+		type user struct {
+			ID    int
+			Name  string
+			Email string
+			Age   int
+			Admin bool
+		}
+		build := func(id int, name, email string, age int, admin bool) user {
+			return user{id, name, email, age, admin}
+		}
+
+		u := Lift5(Just(1), Just("ann"), Just("ann@example.com"), Just(30), Just(false), build)
+		assert.Equal(t, user{1, "ann", "ann@example.com", 30, false}, u.Get())
+
+		missing := Lift5(Just(1), Just("ann"), Nothing[string](), Just(30), Just(false), build)
+		assert.True(t, missing.IsNothing())
+	})
+
+	t.Run("Lift2 to Lift4", func(t *testing.T) {
+		add := func(xs ...int) int {
+			sum := 0
+			for _, x := range xs {
+				sum += x
+			}
+			return sum
+		}
+		assert.Equal(t, 3, Lift2(Just(1), Just(2), func(a, b int) int { return add(a, b) }).Get())
+		assert.Equal(t, 6, Lift3(Just(1), Just(2), Just(3), func(a, b, c int) int { return add(a, b, c) }).Get())
+		assert.True(t, Lift4(Just(1), Just(2), Just(3), Nothing[int](), func(a, b, c, d int) int { return add(a, b, c, d) }).IsNothing())
+	})
+}
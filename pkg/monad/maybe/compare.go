@@ -0,0 +1,38 @@
+package maybe
+
+import "cmp"
+
+// Equal reports whether a and b are both Nothing, or both Just with values equal under eq
+func Equal[T any](a, b Maybe[T], eq func(T, T) bool) bool {
+	if a.value == nil || b.value == nil {
+		return a.value == nil && b.value == nil
+	}
+	return eq(*a.value, *b.value)
+}
+
+// EqualComparable is Equal using ==
+func EqualComparable[T comparable](a, b Maybe[T]) bool {
+	if a.value == nil || b.value == nil {
+		return a.value == nil && b.value == nil
+	}
+	return *a.value == *b.value
+}
+
+// Compare orders Maybes with Nothing before every Just, and Justs by cmp.
+// It can be passed to slices.SortFunc via a closure.
+func Compare[T any](a, b Maybe[T], cmp func(T, T) int) int {
+	switch {
+	case a.value == nil && b.value == nil:
+		return 0
+	case a.value == nil:
+		return -1
+	case b.value == nil:
+		return 1
+	}
+	return cmp(*a.value, *b.value)
+}
+
+// CompareOrdered is Compare using cmp.Compare, and can be passed directly to slices.SortFunc
+func CompareOrdered[T cmp.Ordered](a, b Maybe[T]) int {
+	return Compare(a, b, cmp.Compare[T])
+}
@@ -0,0 +1,32 @@
+package maybe
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("Equal compares values, not pointers", func(t *testing.T) {
+		assert.True(t, EqualComparable(Just(1), Just(1)))
+		assert.False(t, EqualComparable(Just(1), Just(2)))
+		assert.False(t, EqualComparable(Just(0), Nothing[int]()))
+		assert.True(t, EqualComparable(Nothing[int](), Nothing[int]()))
+
+		assert.True(t, Equal(Just("A"), Just("a"), strings.EqualFold))
+		assert.Equal(t, Just(1), Just(1))
+	})
+
+	t.Run("Compare sorts Nothing first", func(t *testing.T) {
+		ms := []Maybe[int]{Just(3), Nothing[int](), Just(1)}
+		slices.SortFunc(ms, CompareOrdered[int])
+		assert.Equal(t, []Maybe[int]{Nothing[int](), Just(1), Just(3)}, ms)
+
+		byLen := func(a, b string) int { return len(a) - len(b) }
+		assert.Negative(t, Compare(Just("a"), Just("bb"), byLen))
+		assert.Zero(t, Compare(Nothing[string](), Nothing[string](), byLen))
+		assert.Positive(t, Compare(Just(""), Nothing[string](), byLen))
+	})
+}
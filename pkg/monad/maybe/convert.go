@@ -0,0 +1,42 @@
+package maybe
+
+// FromPtr returns Just the pointed-to value, or Nothing for a nil pointer
+func FromPtr[T any](p *T) Maybe[T] {
+	if p == nil {
+		return Nothing[T]()
+	}
+	return Just(*p)
+}
+
+// ToPtr returns a pointer to a copy of the value, or nil for Nothing
+func (m Maybe[T]) ToPtr() *T {
+	if m.value == nil {
+		return nil
+	}
+	v := *m.value
+	return &v
+}
+
+// FromOK converts a comma-ok pair into a Maybe
+func FromOK[T any](v T, ok bool) Maybe[T] {
+	if !ok {
+		return Nothing[T]()
+	}
+	return Just(v)
+}
+
+// FromZero treats the zero value of T as missing, so FromZero(0) is Nothing.
+// Use it only where the zero value genuinely means "absent".
+func FromZero[T comparable](v T) Maybe[T] {
+	var zero T
+	if v == zero {
+		return Nothing[T]()
+	}
+	return Just(v)
+}
+
+// FromMapLookup returns Just the value stored under key, or Nothing if the key is absent
+func FromMapLookup[K comparable, V any](m map[K]V, key K) Maybe[V] {
+	v, ok := m[key]
+	return FromOK(v, ok)
+}
@@ -0,0 +1,38 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("FromPtr and ToPtr", func(t *testing.T) {
+		x := 5
+		m := FromPtr(&x)
+		assert.Equal(t, 5, m.Get())
+		assert.True(t, FromPtr[int](nil).IsNothing())
+
+		p := m.ToPtr()
+		*p = 6
+		assert.Equal(t, 5, m.Get(), "ToPtr must not expose internal storage")
+		assert.Nil(t, Nothing[int]().ToPtr())
+	})
+
+	t.Run("FromOK", func(t *testing.T) {
+		assert.Equal(t, 0, FromOK(0, true).Get())
+		assert.True(t, FromOK(1, false).IsNothing())
+	})
+
+	t.Run("FromZero", func(t *testing.T) {
+		assert.True(t, FromZero(0).IsNothing())
+		assert.True(t, FromZero("").IsNothing())
+		assert.Equal(t, 3, FromZero(3).Get())
+	})
+
+	t.Run("FromMapLookup distinguishes zero values from missing keys", func(t *testing.T) {
+		m := map[string]int{"zero": 0}
+		assert.Equal(t, 0, FromMapLookup(m, "zero").Get())
+		assert.True(t, FromMapLookup(m, "missing").IsNothing())
+	})
+}
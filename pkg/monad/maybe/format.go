@@ -0,0 +1,36 @@
+package maybe
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// String renders the Maybe as Just(value) or Nothing
+func (m Maybe[T]) String() string {
+	return fmt.Sprint(m)
+}
+
+// GoString renders the Maybe as the Go expression that builds it
+func (m Maybe[T]) GoString() string {
+	return fmt.Sprintf("%#v", m)
+}
+
+// Format implements fmt.Formatter. The verb and flags are applied to the
+// wrapped value, so %+v and %#v reach nested values; %#v prints Go syntax.
+func (m Maybe[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		typ := reflect.TypeFor[T]().String()
+		if m.value == nil {
+			fmt.Fprintf(f, "maybe.Nothing[%s]()", typ)
+			return
+		}
+		fmt.Fprintf(f, "maybe.Just[%s](%#v)", typ, *m.value)
+		return
+	}
+	if m.value == nil {
+		io.WriteString(f, "Nothing")
+		return
+	}
+	fmt.Fprintf(f, "Just("+fmt.FormatString(f, verb)+")", *m.value)
+}
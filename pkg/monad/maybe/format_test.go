@@ -0,0 +1,38 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "Just(42)", Just(42).String())
+		assert.Equal(t, "Nothing", Nothing[int]().String())
+		assert.Equal(t, "Just(Just(1))", Just(Just(1)).String())
+	})
+
+	t.Run("GoString", func(t *testing.T) {
+		assert.Equal(t, "maybe.Just[int](42)", Just(42).GoString())
+		assert.Equal(t, `maybe.Just[string]("a")`, Just("a").GoString())
+		assert.Equal(t, "maybe.Nothing[int]()", Nothing[int]().GoString())
+		assert.Equal(t, "maybe.Just[maybe.Maybe[int]](maybe.Just[int](1))", Just(Just(1)).GoString())
+	})
+
+	t.Run("verbs reach the wrapped value", func(t *testing.T) {
+		p := Just(point{1, 2})
+		assert.Equal(t, "Just({1 2})", fmt.Sprintf("%v", p))
+		assert.Equal(t, "Just({X:1 Y:2})", fmt.Sprintf("%+v", p))
+		assert.Equal(t, "Just(Just({X:1 Y:2}))", fmt.Sprintf("%+v", Just(p)))
+		assert.Equal(t, "maybe.Just[maybe.point](maybe.point{X:1, Y:2})", fmt.Sprintf("%#v", p))
+		assert.Equal(t, `Just("q")`, fmt.Sprintf("%q", Just("q")))
+		assert.Equal(t, "Just(ff)", fmt.Sprintf("%x", Just(255)))
+		assert.Equal(t, "Just(boom)", fmt.Sprintf("%v", Just(errors.New("boom"))))
+		assert.Equal(t, "Nothing", fmt.Sprintf("%+v", Nothing[point]()))
+	})
+}
@@ -1,5 +1,12 @@
 package maybe
 
+import (
+	"iter"
+	"reflect"
+
+	"github.com/kpse/go-cat/pkg/monad"
+)
+
 // Maybe represents an optional value
 type Maybe[T any] struct {
 	value *T
@@ -13,13 +20,38 @@ func Nothing[T any]() Maybe[T] {
 	return Maybe[T]{value: nil}
 }
 
+// Get returns the value of a Just.
+// It panics with a *monad.AccessError on Nothing.
 func (m Maybe[T]) Get() T {
 	if m.value == nil {
-		panic("Called Get on Nothing")
+		panic(m.accessError("Get", ""))
+	}
+	return *m.value
+}
+
+// TryGet returns the value and true for a Just, or the zero T and false
+func (m Maybe[T]) TryGet() (T, bool) {
+	if m.value == nil {
+		var zero T
+		return zero, false
+	}
+	return *m.value, true
+}
+
+// MustGet is Get with a message describing the caller's expectation,
+// included in the *monad.AccessError on Nothing
+func (m Maybe[T]) MustGet(msg string) T {
+	if m.value == nil {
+		panic(m.accessError("MustGet", msg))
 	}
 	return *m.value
 }
 
+func (m Maybe[T]) accessError(op, msg string) *monad.AccessError {
+	typ := "maybe.Maybe[" + reflect.TypeFor[T]().String() + "]"
+	return monad.NewAccessError(2, typ, op, "value is Nothing", msg)
+}
+
 func (m Maybe[T]) GetOrElse(defaultVal T) T {
 	if m.value == nil {
 		return defaultVal
@@ -56,3 +88,12 @@ func Filter[T any](m Maybe[T], pred func(T) bool) Maybe[T] {
 	}
 	return m
 }
+
+// All returns an iterator yielding the value of a Just, or nothing
+func (m Maybe[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m.value != nil {
+			yield(*m.value)
+		}
+	}
+}
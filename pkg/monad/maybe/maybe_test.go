@@ -1,9 +1,14 @@
 package maybe
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/kpse/go-cat/pkg/monad"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMaybe(t *testing.T) {
@@ -57,4 +62,43 @@ func TestMaybe(t *testing.T) {
 		odd := Filter(Just(5), isEven)
 		assert.True(t, odd.IsNothing())
 	})
+
+	t.Run("All", func(t *testing.T) {
+		assert.Equal(t, []int{3}, slices.Collect(Just(3).All()))
+		assert.Empty(t, slices.Collect(Nothing[int]().All()))
+	})
+
+	t.Run("TryGet", func(t *testing.T) {
+		v, ok := Just(5).TryGet()
+		assert.True(t, ok)
+		assert.Equal(t, 5, v)
+
+		v, ok = Nothing[int]().TryGet()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+	})
+
+	t.Run("panics carry an AccessError", func(t *testing.T) {
+		recovered := func(f func()) (r any) {
+			defer func() { r = recover() }()
+			f()
+			return nil
+		}
+
+		r := recovered(func() { Nothing[int]().MustGet("port must be configured") })
+		err, ok := r.(error)
+		require.True(t, ok)
+
+		var accessErr *monad.AccessError
+		require.True(t, errors.As(err, &accessErr))
+		assert.Equal(t, "maybe.Maybe[int]", accessErr.Type)
+		assert.Equal(t, "MustGet", accessErr.Op)
+		assert.Equal(t, "port must be configured", accessErr.Msg)
+		assert.True(t, strings.HasSuffix(accessErr.File, "maybe_test.go"))
+		assert.Contains(t, accessErr.Func, "TestMaybe")
+
+		r = recovered(func() { Nothing[string]().Get() })
+		assert.IsType(t, &monad.AccessError{}, r)
+		assert.Equal(t, 7, Just(7).MustGet("unused"))
+	})
 }
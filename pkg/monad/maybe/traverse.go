@@ -0,0 +1,59 @@
+package maybe
+
+// Traverse applies f to every element, returning all results or Nothing if any call returns Nothing
+func Traverse[A, B any](xs []A, f func(A) Maybe[B]) Maybe[[]B] {
+	out := make([]B, 0, len(xs))
+	for _, x := range xs {
+		m := f(x)
+		if m.value == nil {
+			return Nothing[[]B]()
+		}
+		out = append(out, *m.value)
+	}
+	return Just(out)
+}
+
+// Sequence turns a slice of Maybes into a Maybe of a slice
+func Sequence[A any](ms []Maybe[A]) Maybe[[]A] {
+	return Traverse(ms, func(m Maybe[A]) Maybe[A] { return m })
+}
+
+// TraverseMap applies f to every value of a map, keeping the keys
+func TraverseMap[K comparable, A, B any](xs map[K]A, f func(A) Maybe[B]) Maybe[map[K]B] {
+	out := make(map[K]B, len(xs))
+	for k, x := range xs {
+		m := f(x)
+		if m.value == nil {
+			return Nothing[map[K]B]()
+		}
+		out[k] = *m.value
+	}
+	return Just(out)
+}
+
+// SequenceMap turns a map of Maybes into a Maybe of a map
+func SequenceMap[K comparable, A any](ms map[K]Maybe[A]) Maybe[map[K]A] {
+	return TraverseMap(ms, func(m Maybe[A]) Maybe[A] { return m })
+}
+
+// CatMaybes keeps the values of the Just elements, in order
+func CatMaybes[A any](ms []Maybe[A]) []A {
+	out := make([]A, 0, len(ms))
+	for _, m := range ms {
+		if m.value != nil {
+			out = append(out, *m.value)
+		}
+	}
+	return out
+}
+
+// MapMaybe applies f to every element and keeps the Just results, in order
+func MapMaybe[A, B any](xs []A, f func(A) Maybe[B]) []B {
+	out := make([]B, 0, len(xs))
+	for _, x := range xs {
+		if m := f(x); m.value != nil {
+			out = append(out, *m.value)
+		}
+	}
+	return out
+}
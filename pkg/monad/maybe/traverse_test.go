@@ -0,0 +1,43 @@
+package maybe
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) Maybe[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Nothing[int]()
+		}
+		return Just(n)
+	}
+
+	t.Run("Traverse", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, Traverse([]string{"1", "2", "3"}, parse).Get())
+		assert.True(t, Traverse([]string{"1", "x"}, parse).IsNothing())
+		assert.Equal(t, []int{}, Traverse(nil, parse).Get())
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Sequence([]Maybe[int]{Just(1), Just(2)}).Get())
+		assert.True(t, Sequence([]Maybe[int]{Just(1), Nothing[int]()}).IsNothing())
+	})
+
+	t.Run("TraverseMap and SequenceMap", func(t *testing.T) {
+		parsed := TraverseMap(map[string]string{"a": "1", "b": "2"}, parse)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, parsed.Get())
+		assert.True(t, TraverseMap(map[string]string{"a": "x"}, parse).IsNothing())
+
+		assert.Equal(t, map[int]int{1: 10}, SequenceMap(map[int]Maybe[int]{1: Just(10)}).Get())
+		assert.True(t, SequenceMap(map[int]Maybe[int]{1: Nothing[int]()}).IsNothing())
+	})
+
+	t.Run("CatMaybes and MapMaybe", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3}, CatMaybes([]Maybe[int]{Just(1), Nothing[int](), Just(3)}))
+		assert.Equal(t, []int{4, 6}, MapMaybe([]string{"4", "five", "6"}, parse))
+	})
+}
@@ -0,0 +1,41 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// ErrMissingValue is returned by Value when the context has no usable value for the key
+var ErrMissingValue = errors.New("reader: context value missing")
+
+// Value creates a ReaderEither that looks up key in the context environment.
+// It fails with an error wrapping ErrMissingValue if the key is absent or holds another type.
+func Value[V any](key any) ReaderEither[context.Context, error, V] {
+	return func(ctx context.Context) either.Either[error, V] {
+		v, ok := ctx.Value(key).(V)
+		if !ok {
+			return either.Left[error, V](fmt.Errorf("%w: key %v", ErrMissingValue, key))
+		}
+		return either.Right[error](v)
+	}
+}
+
+// WithValue runs re in a context carrying key/value
+func WithValue[A any](re ReaderEither[context.Context, error, A], key, value any) ReaderEither[context.Context, error, A] {
+	return LocalEither(re, func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, key, value)
+	})
+}
+
+// Checked runs re only while the context is live, failing with ctx.Err() otherwise
+func Checked[A any](re ReaderEither[context.Context, error, A]) ReaderEither[context.Context, error, A] {
+	return func(ctx context.Context) either.Either[error, A] {
+		if err := ctx.Err(); err != nil {
+			return either.Left[error, A](err)
+		}
+		return re(ctx)
+	}
+}
@@ -0,0 +1,51 @@
+// Package reader provides the Reader monad, a computation that depends on a
+// shared read-only environment such as configuration or clients.
+package reader
+
+// Reader represents a computation that reads from an environment R to produce A
+type Reader[R any, A any] func(R) A
+
+// Of creates a Reader that ignores the environment and returns a
+func Of[R any, A any](a A) Reader[R, A] {
+	return func(R) A {
+		return a
+	}
+}
+
+// Ask creates a Reader that returns the environment itself
+func Ask[R any]() Reader[R, R] {
+	return func(r R) R {
+		return r
+	}
+}
+
+// Asks creates a Reader that returns a projection of the environment
+func Asks[R any, A any](f func(R) A) Reader[R, A] {
+	return f
+}
+
+// Local runs a Reader in an environment modified by f
+func Local[R any, A any](rd Reader[R, A], f func(R) R) Reader[R, A] {
+	return func(r R) A {
+		return rd(f(r))
+	}
+}
+
+// Run executes the Reader against an environment
+func (rd Reader[R, A]) Run(env R) A {
+	return rd(env)
+}
+
+// Map applies a function to the result of a Reader
+func Map[R any, A any, B any](rd Reader[R, A], f func(A) B) Reader[R, B] {
+	return func(r R) B {
+		return f(rd(r))
+	}
+}
+
+// Bind sequences two Readers sharing the same environment
+func Bind[R any, A any, B any](rd Reader[R, A], f func(A) Reader[R, B]) Reader[R, B] {
+	return func(r R) B {
+		return f(rd(r))(r)
+	}
+}
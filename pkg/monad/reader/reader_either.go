@@ -0,0 +1,58 @@
+package reader
+
+import "github.com/kpse/go-cat/pkg/monad/either"
+
+// ReaderEither represents a computation that reads from an environment R and may fail with E
+type ReaderEither[R any, E any, A any] func(R) either.Either[E, A]
+
+// Right creates a ReaderEither that succeeds with a
+func Right[R any, E any, A any](a A) ReaderEither[R, E, A] {
+	return FromEither[R](either.Right[E](a))
+}
+
+// Left creates a ReaderEither that fails with e
+func Left[R any, E any, A any](e E) ReaderEither[R, E, A] {
+	return FromEither[R](either.Left[E, A](e))
+}
+
+// FromEither creates a ReaderEither that ignores the environment and returns e
+func FromEither[R any, E any, A any](e either.Either[E, A]) ReaderEither[R, E, A] {
+	return func(R) either.Either[E, A] {
+		return e
+	}
+}
+
+// FromReader lifts a Reader that cannot fail into a ReaderEither
+func FromReader[E any, R any, A any](rd Reader[R, A]) ReaderEither[R, E, A] {
+	return func(r R) either.Either[E, A] {
+		return either.Right[E](rd(r))
+	}
+}
+
+// Run executes the ReaderEither against an environment
+func (re ReaderEither[R, E, A]) Run(env R) either.Either[E, A] {
+	return re(env)
+}
+
+// LocalEither runs a ReaderEither in an environment modified by f
+func LocalEither[R any, E any, A any](re ReaderEither[R, E, A], f func(R) R) ReaderEither[R, E, A] {
+	return func(r R) either.Either[E, A] {
+		return re(f(r))
+	}
+}
+
+// MapEither applies a function to the successful result of a ReaderEither
+func MapEither[R any, E any, A any, B any](re ReaderEither[R, E, A], f func(A) B) ReaderEither[R, E, B] {
+	return func(r R) either.Either[E, B] {
+		return either.Map(re(r), f)
+	}
+}
+
+// BindEither sequences two ReaderEithers, stopping at the first failure
+func BindEither[R any, E any, A any, B any](re ReaderEither[R, E, A], f func(A) ReaderEither[R, E, B]) ReaderEither[R, E, B] {
+	return func(r R) either.Either[E, B] {
+		return either.Bind(re(r), func(a A) either.Either[E, B] {
+			return f(a)(r)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type config struct {
+	Host string
+	Port int
+}
+
+func TestReader(t *testing.T) {
+	cfg := config{Host: "localhost", Port: 8080}
+
+	t.Run("Ask and Asks", func(t *testing.T) {
+		assert.Equal(t, cfg, Ask[config]().Run(cfg))
+		assert.Equal(t, 8080, Asks(func(c config) int { return c.Port }).Run(cfg))
+		assert.Equal(t, "x", Of[config]("x").Run(cfg))
+	})
+
+	t.Run("Map and Bind share the environment", func(t *testing.T) {
+		host := Asks(func(c config) string { return c.Host })
+		addr := Bind(host, func(h string) Reader[config, string] {
+			return Map(Asks(func(c config) int { return c.Port }), func(p int) string {
+				return fmt.Sprintf("%s:%d", h, p)
+			})
+		})
+		assert.Equal(t, "localhost:8080", addr.Run(cfg))
+	})
+
+	t.Run("Local modifies the environment for one computation", func(t *testing.T) {
+		port := Asks(func(c config) int { return c.Port })
+		testPort := Local(port, func(c config) config { c.Port = 0; return c })
+		assert.Equal(t, 0, testPort.Run(cfg))
+		assert.Equal(t, 8080, port.Run(cfg))
+	})
+}
+
+func TestReaderEither(t *testing.T) {
+	cfg := config{Host: "localhost", Port: 8080}
+	validPort := func(p int) ReaderEither[config, string, int] {
+		if p <= 0 {
+			return Left[config, string, int]("invalid port")
+		}
+		return Right[config, string](p)
+	}
+	port := FromReader[string](Asks(func(c config) int { return c.Port }))
+
+	t.Run("BindEither and MapEither", func(t *testing.T) {
+		checked := MapEither(BindEither(port, validPort), func(p int) string { return fmt.Sprint(p) })
+		assert.Equal(t, "8080", checked.Run(cfg).GetRight())
+		assert.Equal(t, "invalid port", checked.Run(config{}).GetLeft())
+	})
+
+	t.Run("LocalEither", func(t *testing.T) {
+		zeroed := LocalEither(BindEither(port, validPort), func(c config) config { c.Port = 0; return c })
+		assert.True(t, zeroed.Run(cfg).IsLeft())
+	})
+}
+
+func TestContext(t *testing.T) {
+	type userKey struct{}
+
+	greeting := MapEither(Value[string](userKey{}), func(u string) string { return "hello " + u })
+
+	t.Run("Value and WithValue", func(t *testing.T) {
+		assert.Equal(t, "hello ann", WithValue(greeting, userKey{}, "ann").Run(context.Background()).GetRight())
+
+		missing := greeting.Run(context.Background())
+		assert.ErrorIs(t, missing.GetLeft(), ErrMissingValue)
+
+		wrongType := WithValue(greeting, userKey{}, 42).Run(context.Background())
+		assert.ErrorIs(t, wrongType.GetLeft(), ErrMissingValue)
+	})
+
+	t.Run("Checked stops on a cancelled context", func(t *testing.T) {
+		ran := false
+		op := Checked(FromReader[error](func(context.Context) string { ran = true; return "done" }))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result := op.Run(ctx)
+		assert.True(t, errors.Is(result.GetLeft(), context.Canceled))
+		assert.False(t, ran)
+
+		assert.Equal(t, "done", op.Run(context.Background()).GetRight())
+	})
+}
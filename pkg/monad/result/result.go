@@ -0,0 +1,133 @@
+// Package result provides Result, an Either specialised to Go's error
+// convention, and helpers for moving between it and (T, error) pairs.
+package result
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// Result is an Either whose Left side is an error.
+// The embedded Either gives access to all of its methods and can be passed
+// directly to the functions of package either.
+type Result[T any] struct {
+	either.Either[error, T]
+}
+
+// ErrNilError stands in for a nil error used to build a failed Result, so
+// that a failure can never be mistaken for success with a zero value
+var ErrNilError = errors.New("result: failed with a nil error")
+
+// Ok creates a successful Result
+func Ok[T any](v T) Result[T] {
+	return Result[T]{either.Right[error, T](v)}
+}
+
+// Err creates a failed Result. A nil err is replaced by ErrNilError.
+func Err[T any](err error) Result[T] {
+	return Result[T]{either.Left[error, T](nonNil(err))}
+}
+
+// FromEither wraps an Either[error, T] as a Result.
+// A Left holding a nil error is replaced by ErrNilError.
+func FromEither[T any](e either.Either[error, T]) Result[T] {
+	if err, ok := e.LeftValue(); ok {
+		return Err[T](err)
+	}
+	return Result[T]{e}
+}
+
+func nonNil(err error) error {
+	if err == nil {
+		return ErrNilError
+	}
+	return err
+}
+
+// FromPair converts a (T, error) pair into a Result.
+// A non-nil error always produces a failed Result, regardless of v.
+func FromPair[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// ToPair converts the Result back into a (T, error) pair
+func (r Result[T]) ToPair() (T, error) {
+	if err, ok := r.LeftValue(); ok {
+		var zero T
+		return zero, nonNil(err)
+	}
+	return r.GetRight(), nil
+}
+
+// Err returns the error of a failed Result, or nil for a successful one
+func (r Result[T]) Err() error {
+	if err, ok := r.LeftValue(); ok {
+		return nonNil(err)
+	}
+	return nil
+}
+
+// Try runs f and wraps its return values as a Result
+func Try[T any](f func() (T, error)) Result[T] {
+	return FromPair(f())
+}
+
+// PanicError is the error held by a Result produced by TryCatch when f panics
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns the panic value if it was itself an error
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// TryCatch runs f like Try, but also converts a panic into a failed Result
+// holding a *PanicError
+func TryCatch[T any](f func() (T, error)) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return Try(f)
+}
+
+// Is reports whether the Result failed with an error matching target, as errors.Is
+func Is[T any](r Result[T], target error) bool {
+	err, ok := r.LeftValue()
+	return ok && errors.Is(nonNil(err), target)
+}
+
+// As finds the first error in the failure chain matching target, as errors.As.
+// It returns false for a successful Result.
+func As[T any](r Result[T], target any) bool {
+	err, ok := r.LeftValue()
+	return ok && errors.As(nonNil(err), target)
+}
+
+// Map applies a function to the value of a successful Result
+func Map[T any, U any](r Result[T], f func(T) U) Result[U] {
+	return Result[U]{either.Map(r.Either, f)}
+}
+
+// Bind chains a computation that may fail onto a successful Result
+func Bind[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	return Result[U]{either.Bind(r.Either, func(v T) either.Either[error, U] {
+		return f(v).Either
+	})}
+}
@@ -0,0 +1,94 @@
+package result
+
+import (
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("FromPair and ToPair round trip", func(t *testing.T) {
+		ok := FromPair(strconv.Atoi("42"))
+		assert.True(t, ok.IsRight())
+		v, err := ok.ToPair()
+		assert.NoError(t, err)
+		assert.Equal(t, 42, v)
+
+		failed := FromPair(strconv.Atoi("x"))
+		assert.True(t, failed.IsLeft())
+		v, err = failed.ToPair()
+		assert.Error(t, err)
+		assert.Zero(t, v)
+	})
+
+	t.Run("error wins over value", func(t *testing.T) {
+		r := FromPair(7, errors.New("boom"))
+		assert.True(t, r.IsLeft())
+		assert.EqualError(t, r.Err(), "boom")
+	})
+
+	t.Run("nil error still fails", func(t *testing.T) {
+		for _, r := range []Result[int]{
+			Err[int](nil),
+			FromEither(either.Left[error, int](nil)),
+			{either.Left[error, int](nil)},
+		} {
+			assert.True(t, r.IsLeft())
+			assert.ErrorIs(t, r.Err(), ErrNilError)
+			assert.True(t, Is(r, ErrNilError))
+			_, err := r.ToPair()
+			assert.ErrorIs(t, err, ErrNilError)
+		}
+	})
+
+	t.Run("interoperates with either", func(t *testing.T) {
+		r := Ok(21)
+		doubled := either.Map(r.Either, func(x int) int { return x * 2 })
+		assert.Equal(t, 42, FromEither(doubled).GetRight())
+	})
+
+	t.Run("Try", func(t *testing.T) {
+		r := Try(func() (int, error) { return strconv.Atoi("5") })
+		assert.Equal(t, 5, r.GetRight())
+	})
+
+	t.Run("TryCatch captures panics", func(t *testing.T) {
+		r := TryCatch(func() (int, error) { panic("bad input") })
+		require.True(t, r.IsLeft())
+
+		var pe *PanicError
+		require.True(t, As(r, &pe))
+		assert.Equal(t, "bad input", pe.Value)
+		assert.NotEmpty(t, pe.Stack)
+	})
+
+	t.Run("TryCatch unwraps panicked errors", func(t *testing.T) {
+		r := TryCatch(func() (int, error) { panic(fs.ErrNotExist) })
+		assert.True(t, Is(r, fs.ErrNotExist))
+	})
+
+	t.Run("Is and As inspect the failure", func(t *testing.T) {
+		r := Err[int](&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist})
+		assert.True(t, Is(r, fs.ErrNotExist))
+
+		var pathErr *fs.PathError
+		assert.True(t, As(r, &pathErr))
+		assert.Equal(t, "x", pathErr.Path)
+
+		assert.False(t, Is(Ok(1), fs.ErrNotExist))
+		assert.False(t, As(Ok(1), &pathErr))
+	})
+
+	t.Run("Map and Bind", func(t *testing.T) {
+		parse := func(s string) Result[int] { return FromPair(strconv.Atoi(s)) }
+
+		assert.Equal(t, 10, Map(parse("5"), func(x int) int { return x * 2 }).GetRight())
+		assert.True(t, Bind(Ok("x"), parse).IsLeft())
+		assert.Equal(t, 3, Bind(Ok("3"), parse).GetRight())
+	})
+}
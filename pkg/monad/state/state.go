@@ -0,0 +1,97 @@
+// Package state provides the State monad, a computation that threads a
+// state value of type S through a sequence of steps.
+package state
+
+// State represents a computation that reads a state and returns a result with the new state
+type State[S any, A any] func(S) (A, S)
+
+// Of creates a State that returns a without touching the state
+func Of[S any, A any](a A) State[S, A] {
+	return func(s S) (A, S) {
+		return a, s
+	}
+}
+
+// Get creates a State that returns the current state
+func Get[S any]() State[S, S] {
+	return func(s S) (S, S) {
+		return s, s
+	}
+}
+
+// Gets creates a State that returns a projection of the current state
+func Gets[S any, A any](f func(S) A) State[S, A] {
+	return func(s S) (A, S) {
+		return f(s), s
+	}
+}
+
+// Put creates a State that replaces the current state
+func Put[S any](s S) State[S, struct{}] {
+	return func(S) (struct{}, S) {
+		return struct{}{}, s
+	}
+}
+
+// Modify creates a State that updates the current state with f
+func Modify[S any](f func(S) S) State[S, struct{}] {
+	return func(s S) (struct{}, S) {
+		return struct{}{}, f(s)
+	}
+}
+
+// Run executes the computation from an initial state, returning the result and final state
+func (st State[S, A]) Run(initial S) (A, S) {
+	return st(initial)
+}
+
+// Eval executes the computation and returns only the result
+func (st State[S, A]) Eval(initial S) A {
+	a, _ := st(initial)
+	return a
+}
+
+// Exec executes the computation and returns only the final state
+func (st State[S, A]) Exec(initial S) S {
+	_, s := st(initial)
+	return s
+}
+
+// Map applies a function to the result of a State
+func Map[S any, A any, B any](st State[S, A], f func(A) B) State[S, B] {
+	return func(s S) (B, S) {
+		a, next := st(s)
+		return f(a), next
+	}
+}
+
+// Bind sequences two stateful computations, feeding the result of the first to f
+func Bind[S any, A any, B any](st State[S, A], f func(A) State[S, B]) State[S, B] {
+	return func(s S) (B, S) {
+		a, next := st(s)
+		return f(a)(next)
+	}
+}
+
+// Then sequences two computations, discarding the result of the first
+func Then[S any, A any, B any](first State[S, A], second State[S, B]) State[S, B] {
+	return Bind(first, func(A) State[S, B] { return second })
+}
+
+// Traverse runs f for every element in order, threading the state through each
+func Traverse[S any, A any, B any](xs []A, f func(A) State[S, B]) State[S, []B] {
+	return func(s S) ([]B, S) {
+		out := make([]B, 0, len(xs))
+		for _, x := range xs {
+			var b B
+			b, s = f(x)(s)
+			out = append(out, b)
+		}
+		return out, s
+	}
+}
+
+// Sequence runs the computations in order, collecting their results
+func Sequence[S any, A any](sts []State[S, A]) State[S, []A] {
+	return Traverse(sts, func(st State[S, A]) State[S, A] { return st })
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	t.Run("Get, Put and Modify", func(t *testing.T) {
+		prog := Then(Put(10), Then(Modify(func(s int) int { return s * 2 }), Get[int]()))
+		a, s := prog.Run(0)
+		assert.Equal(t, 20, a)
+		assert.Equal(t, 20, s)
+	})
+
+	t.Run("Gets projects the state", func(t *testing.T) {
+		type env struct{ name string }
+		assert.Equal(t, "svc", Gets(func(e env) string { return e.name }).Eval(env{"svc"}))
+	})
+
+	t.Run("Of leaves state untouched", func(t *testing.T) {
+		a, s := Of[int]("x").Run(5)
+		assert.Equal(t, "x", a)
+		assert.Equal(t, 5, s)
+	})
+
+	t.Run("Map and Bind", func(t *testing.T) {
+		next := func() State[int, int] {
+			return func(s int) (int, int) { return s, s + 1 }
+		}
+		label := Map(next(), func(id int) string { return fmt.Sprintf("id-%d", id) })
+		pair := Bind(label, func(first string) State[int, string] {
+			return Map(next(), func(id int) string { return fmt.Sprintf("%s,id-%d", first, id) })
+		})
+
+		a, s := pair.Run(1)
+		assert.Equal(t, "id-1,id-2", a)
+		assert.Equal(t, 3, s)
+	})
+
+	t.Run("Traverse allocates IDs in order", func(t *testing.T) {
+		allocate := func(name string) State[int, string] {
+			return func(next int) (string, int) {
+				return fmt.Sprintf("%s#%d", name, next), next + 1
+			}
+		}
+
+		ids, next := Traverse([]string{"a", "b", "c"}, allocate).Run(100)
+		assert.Equal(t, []string{"a#100", "b#101", "c#102"}, ids)
+		assert.Equal(t, 103, next)
+		assert.Equal(t, 100, Traverse(nil, allocate).Exec(100))
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		inc := Modify(func(s int) int { return s + 1 })
+		assert.Equal(t, 3, Sequence([]State[int, struct{}]{inc, inc, inc}).Exec(0))
+	})
+}
@@ -0,0 +1,101 @@
+package validated
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kpse/go-cat/pkg/base"
+)
+
+// FieldErrors maps a field path such as "server.ports[1]" to the errors
+// reported for it. The empty path holds errors about the value itself.
+type FieldErrors map[string][]error
+
+// Error lists every field error, sorted by path
+func (fe FieldErrors) Error() string {
+	var lines []string
+	for _, path := range fe.paths() {
+		for _, err := range fe[path] {
+			if path == "" {
+				lines = append(lines, err.Error())
+			} else {
+				lines = append(lines, fmt.Sprintf("%s: %v", path, err))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns every field error in the order Error lists them, so that
+// errors.Is and errors.As can find a specific failure
+func (fe FieldErrors) Unwrap() []error {
+	var errs []error
+	for _, path := range fe.paths() {
+		errs = append(errs, fe[path]...)
+	}
+	return errs
+}
+
+func (fe FieldErrors) paths() []string {
+	paths := make([]string, 0, len(fe))
+	for path := range fe {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// FieldErrorsMonoid merges field errors into a new map, keeping the errors
+// for a shared path in order
+func FieldErrorsMonoid() base.Monoid[FieldErrors] {
+	return base.NewMonoid(FieldErrors(nil), func(x, y FieldErrors) FieldErrors {
+		out := make(FieldErrors, len(x)+len(y))
+		for path, errs := range x {
+			out[path] = append(out[path], errs...)
+		}
+		for path, errs := range y {
+			out[path] = append(out[path], errs...)
+		}
+		return out
+	})
+}
+
+// Fail creates an invalid result with a single error for the value itself
+func Fail[A any](err error) Validated[FieldErrors, A] {
+	return Invalid[FieldErrors, A](FieldErrors{"": {err}})
+}
+
+// Check validates a value with a function returning nil on success
+func Check[A any](a A, check func(A) error) Validated[FieldErrors, A] {
+	if err := check(a); err != nil {
+		return Fail[A](err)
+	}
+	return Valid[FieldErrors](a)
+}
+
+// Field nests the errors of v under the named field
+func Field[A any](name string, v Validated[FieldErrors, A]) Validated[FieldErrors, A] {
+	return MapErrors(v, func(fe FieldErrors) FieldErrors { return prefix(fe, name) })
+}
+
+// Index nests the errors of v under the given slice index
+func Index[A any](i int, v Validated[FieldErrors, A]) Validated[FieldErrors, A] {
+	return MapErrors(v, func(fe FieldErrors) FieldErrors { return prefix(fe, fmt.Sprintf("[%d]", i)) })
+}
+
+func prefix(fe FieldErrors, segment string) FieldErrors {
+	out := make(FieldErrors, len(fe))
+	for path, errs := range fe {
+		switch {
+		case path == "":
+			path = segment
+		case strings.HasPrefix(path, "["):
+			path = segment + path
+		default:
+			path = segment + "." + path
+		}
+		out[path] = append(out[path], errs...)
+	}
+	return out
+}
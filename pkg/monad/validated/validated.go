@@ -0,0 +1,161 @@
+// Package validated provides Validated, an Either-like type whose applicative
+// combinators accumulate every failure instead of stopping at the first one.
+package validated
+
+import (
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// Validated holds either a valid value A or accumulated errors E
+type Validated[E any, A any] struct {
+	invalid bool
+	errs    E
+	value   A
+}
+
+// Valid creates a successful Validated
+func Valid[E any, A any](a A) Validated[E, A] {
+	return Validated[E, A]{value: a}
+}
+
+// Invalid creates a failed Validated
+func Invalid[E any, A any](e E) Validated[E, A] {
+	return Validated[E, A]{invalid: true, errs: e}
+}
+
+// IsValid returns true if the Validated holds a value
+func (v Validated[E, A]) IsValid() bool {
+	return !v.invalid
+}
+
+// IsInvalid returns true if the Validated holds errors
+func (v Validated[E, A]) IsInvalid() bool {
+	return v.invalid
+}
+
+// Value returns the valid value and true, or the zero A and false
+func (v Validated[E, A]) Value() (A, bool) {
+	if v.invalid {
+		var zero A
+		return zero, false
+	}
+	return v.value, true
+}
+
+// Errors returns the accumulated errors and true, or the zero E and false
+func (v Validated[E, A]) Errors() (E, bool) {
+	if !v.invalid {
+		var zero E
+		return zero, false
+	}
+	return v.errs, true
+}
+
+// FromEither converts an Either, treating Left as the errors
+func FromEither[E any, A any](e either.Either[E, A]) Validated[E, A] {
+	if errs, ok := e.LeftValue(); ok {
+		return Invalid[E, A](errs)
+	}
+	return Valid[E](e.GetRight())
+}
+
+// ToEither converts to an Either, placing the errors on the Left
+func ToEither[E any, A any](v Validated[E, A]) either.Either[E, A] {
+	if v.invalid {
+		return either.Left[E, A](v.errs)
+	}
+	return either.Right[E](v.value)
+}
+
+// Match pattern matches on Validated, applying the appropriate function
+func Match[E any, A any, B any](v Validated[E, A], invalidFn func(E) B, validFn func(A) B) B {
+	if v.invalid {
+		return invalidFn(v.errs)
+	}
+	return validFn(v.value)
+}
+
+// Map applies a function to a valid value
+func Map[E any, A any, B any](v Validated[E, A], f func(A) B) Validated[E, B] {
+	if v.invalid {
+		return Invalid[E, B](v.errs)
+	}
+	return Valid[E](f(v.value))
+}
+
+// MapErrors applies a function to the accumulated errors
+func MapErrors[E any, F any, A any](v Validated[E, A], f func(E) F) Validated[F, A] {
+	if v.invalid {
+		return Invalid[F, A](f(v.errs))
+	}
+	return Valid[F](v.value)
+}
+
+// AndThen chains a dependent validation. Unlike Ap it cannot accumulate:
+// f needs the valid value, so it only runs when v is valid.
+func AndThen[E any, A any, B any](v Validated[E, A], f func(A) Validated[E, B]) Validated[E, B] {
+	if v.invalid {
+		return Invalid[E, B](v.errs)
+	}
+	return f(v.value)
+}
+
+// Ap applies a validated function to a validated value, combining the
+// errors of both sides when both are invalid
+func Ap[E any, A any, B any](s base.Semigroup[E], vf Validated[E, func(A) B], va Validated[E, A]) Validated[E, B] {
+	switch {
+	case vf.invalid && va.invalid:
+		return Invalid[E, B](s.Combine(vf.errs, va.errs))
+	case vf.invalid:
+		return Invalid[E, B](vf.errs)
+	case va.invalid:
+		return Invalid[E, B](va.errs)
+	default:
+		return Valid[E](vf.value(va.value))
+	}
+}
+
+// Map2 combines two independent validations, accumulating their errors
+func Map2[E any, A any, B any, C any](s base.Semigroup[E], va Validated[E, A], vb Validated[E, B], f func(A, B) C) Validated[E, C] {
+	curried := Map(va, func(a A) func(B) C {
+		return func(b B) C { return f(a, b) }
+	})
+	return Ap(s, curried, vb)
+}
+
+// Map3 combines three independent validations, accumulating their errors
+func Map3[E any, A any, B any, C any, D any](s base.Semigroup[E], va Validated[E, A], vb Validated[E, B], vc Validated[E, C], f func(A, B, C) D) Validated[E, D] {
+	curried := Map2(s, va, vb, func(a A, b B) func(C) D {
+		return func(c C) D { return f(a, b, c) }
+	})
+	return Ap(s, curried, vc)
+}
+
+// Sequence collects valid values in order, or every error in order
+func Sequence[E any, A any](s base.Semigroup[E], vs []Validated[E, A]) Validated[E, []A] {
+	return Traverse(s, vs, func(v Validated[E, A]) Validated[E, A] { return v })
+}
+
+// Traverse validates every element and collects the results, accumulating
+// the errors of all invalid elements
+func Traverse[E any, A any, B any](s base.Semigroup[E], xs []A, f func(A) Validated[E, B]) Validated[E, []B] {
+	out := make([]B, 0, len(xs))
+	var errs E
+	invalid := false
+	for _, x := range xs {
+		v := f(x)
+		switch {
+		case !v.invalid:
+			out = append(out, v.value)
+		case invalid:
+			errs = s.Combine(errs, v.errs)
+		default:
+			errs, invalid = v.errs, true
+		}
+	}
+	if invalid {
+		return Invalid[E, []B](errs)
+	}
+	return Valid[E](out)
+}
@@ -0,0 +1,177 @@
+package validated
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidated(t *testing.T) {
+	strs := base.SliceMonoid[string]()
+
+	positive := func(x int) Validated[[]string, int] {
+		if x <= 0 {
+			return Invalid[[]string, int]([]string{"not positive"})
+		}
+		return Valid[[]string](x)
+	}
+	nonEmpty := func(s string) Validated[[]string, string] {
+		if s == "" {
+			return Invalid[[]string, string]([]string{"empty"})
+		}
+		return Valid[[]string](s)
+	}
+
+	t.Run("Map2 accumulates both failures", func(t *testing.T) {
+		type pair struct {
+			n int
+			s string
+		}
+		build := func(n int, s string) pair { return pair{n, s} }
+
+		ok := Map2(strs, positive(1), nonEmpty("a"), build)
+		v, valid := ok.Value()
+		assert.True(t, valid)
+		assert.Equal(t, pair{1, "a"}, v)
+
+		bad := Map2(strs, positive(0), nonEmpty(""), build)
+		errs, invalid := bad.Errors()
+		assert.True(t, invalid)
+		assert.Equal(t, []string{"not positive", "empty"}, errs)
+	})
+
+	t.Run("Map3 keeps errors in argument order", func(t *testing.T) {
+		sum := Map3(strs, positive(-1), positive(2), positive(-3), func(a, b, c int) int { return a + b + c })
+		errs, _ := sum.Errors()
+		assert.Equal(t, []string{"not positive", "not positive"}, errs)
+	})
+
+	t.Run("Traverse and Sequence", func(t *testing.T) {
+		all := Traverse(strs, []int{1, 2, 3}, positive)
+		v, _ := all.Value()
+		assert.Equal(t, []int{1, 2, 3}, v)
+
+		some := Sequence(strs, []Validated[[]string, int]{positive(1), positive(0), positive(-1)})
+		errs, _ := some.Errors()
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("AndThen short-circuits", func(t *testing.T) {
+		called := false
+		AndThen(positive(0), func(x int) Validated[[]string, int] {
+			called = true
+			return positive(x)
+		})
+		assert.False(t, called)
+	})
+
+	t.Run("Either conversions", func(t *testing.T) {
+		assert.True(t, FromEither(either.Left[string, int]("x")).IsInvalid())
+		assert.True(t, FromEither(either.Right[string, int](1)).IsValid())
+		assert.Equal(t, 4, ToEither(positive(4)).GetRight())
+		assert.Equal(t, []string{"empty"}, ToEither(nonEmpty("")).GetLeft())
+	})
+
+	t.Run("errors.Join semigroup", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		v := Map2(base.ErrorMonoid(), Invalid[error, int](errA), Invalid[error, int](errB), func(a, b int) int { return a + b })
+		err, _ := v.Errors()
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errB)
+	})
+}
+
+func TestFieldErrors(t *testing.T) {
+	type server struct {
+		Host  string
+		Ports []int
+	}
+	type config struct {
+		Name   string
+		Server server
+	}
+
+	fields := FieldErrorsMonoid()
+	errEmpty := errors.New("must not be empty")
+	errPort := errors.New("port out of range")
+
+	notEmpty := func(s string) error {
+		if s == "" {
+			return errEmpty
+		}
+		return nil
+	}
+	validPort := func(p int) error {
+		if p <= 0 || p > 65535 {
+			return errPort
+		}
+		return nil
+	}
+
+	validateServer := func(s server) Validated[FieldErrors, server] {
+		ports := Traverse(fields, indexed(s.Ports), func(ip indexedInt) Validated[FieldErrors, int] {
+			return Index(ip.i, Check(ip.v, validPort))
+		})
+		return Map2(fields,
+			Field("host", Check(s.Host, notEmpty)),
+			Field("ports", ports),
+			func(host string, ports []int) server { return server{host, ports} },
+		)
+	}
+	validateConfig := func(c config) Validated[FieldErrors, config] {
+		return Map2(fields,
+			Field("name", Check(c.Name, notEmpty)),
+			Field("server", validateServer(c.Server)),
+			func(name string, s server) config { return config{name, s} },
+		)
+	}
+
+	t.Run("valid config passes", func(t *testing.T) {
+		c := config{Name: "svc", Server: server{Host: "localhost", Ports: []int{80, 443}}}
+		v, ok := validateConfig(c).Value()
+		require.True(t, ok)
+		assert.Equal(t, c, v)
+	})
+
+	t.Run("all nested errors are reported with paths", func(t *testing.T) {
+		c := config{Server: server{Ports: []int{80, 0, 70000}}}
+		errs, ok := validateConfig(c).Errors()
+		require.True(t, ok)
+
+		assert.Equal(t, FieldErrors{
+			"name":            {errEmpty},
+			"server.host":     {errEmpty},
+			"server.ports[1]": {errPort},
+			"server.ports[2]": {errPort},
+		}, errs)
+		assert.Equal(t, strings.Join([]string{
+			"name: must not be empty",
+			"server.host: must not be empty",
+			"server.ports[1]: port out of range",
+			"server.ports[2]: port out of range",
+		}, "\n"), errs.Error())
+
+		assert.ErrorIs(t, errs, errEmpty)
+		assert.ErrorIs(t, errs, errPort)
+		assert.NotErrorIs(t, errs, errors.New("must not be empty"))
+		assert.Equal(t, []error{errEmpty, errEmpty, errPort, errPort}, errs.Unwrap())
+	})
+}
+
+type indexedInt struct {
+	i int
+	v int
+}
+
+func indexed(xs []int) []indexedInt {
+	out := make([]indexedInt, len(xs))
+	for i, x := range xs {
+		out[i] = indexedInt{i, x}
+	}
+	return out
+}
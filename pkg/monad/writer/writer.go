@@ -0,0 +1,85 @@
+// Package writer provides the Writer monad, a computation that produces a
+// value together with an accumulated output such as a log or metrics.
+//
+// Outputs are combined with a base.Monoid, passed explicitly to the
+// functions that need to combine or create outputs.
+package writer
+
+import (
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/data/tuple"
+)
+
+// Writer holds a value of type A and the output W produced while computing it
+type Writer[W any, A any] struct {
+	value  A
+	output W
+}
+
+// New creates a Writer from a value and its output
+func New[W any, A any](a A, w W) Writer[W, A] {
+	return Writer[W, A]{value: a, output: w}
+}
+
+// Of creates a Writer with an empty output
+func Of[W any, A any](m base.Monoid[W], a A) Writer[W, A] {
+	return New(a, m.Empty())
+}
+
+// Tell creates a Writer that only produces output
+func Tell[W any](w W) Writer[W, struct{}] {
+	return New(struct{}{}, w)
+}
+
+// Run returns the value and the accumulated output
+func (w Writer[W, A]) Run() (A, W) {
+	return w.value, w.output
+}
+
+// Value returns the value, discarding the output
+func (w Writer[W, A]) Value() A {
+	return w.value
+}
+
+// Output returns the output, discarding the value
+func (w Writer[W, A]) Output() W {
+	return w.output
+}
+
+// Map applies a function to the value, keeping the output
+func Map[W any, A any, B any](w Writer[W, A], f func(A) B) Writer[W, B] {
+	return New(f(w.value), w.output)
+}
+
+// Bind sequences two Writers, combining their outputs in order
+func Bind[W any, A any, B any](m base.Monoid[W], w Writer[W, A], f func(A) Writer[W, B]) Writer[W, B] {
+	next := f(w.value)
+	return New(next.value, m.Combine(w.output, next.output))
+}
+
+// Then sequences two Writers, discarding the value of the first
+func Then[W any, A any, B any](m base.Monoid[W], first Writer[W, A], second Writer[W, B]) Writer[W, B] {
+	return New(second.value, m.Combine(first.output, second.output))
+}
+
+// Listen pairs the value with the output produced so far
+func Listen[W any, A any](w Writer[W, A]) Writer[W, tuple.Pair[A, W]] {
+	return New(tuple.NewPair(w.value, w.output), w.output)
+}
+
+// Censor transforms the output, for example to redact or filter entries
+func Censor[W any, A any](w Writer[W, A], f func(W) W) Writer[W, A] {
+	return New(w.value, f(w.output))
+}
+
+// Traverse runs f for every element in order, combining all outputs
+func Traverse[W any, A any, B any](m base.Monoid[W], xs []A, f func(A) Writer[W, B]) Writer[W, []B] {
+	out := make([]B, 0, len(xs))
+	acc := m.Empty()
+	for _, x := range xs {
+		w := f(x)
+		out = append(out, w.value)
+		acc = m.Combine(acc, w.output)
+	}
+	return New(out, acc)
+}
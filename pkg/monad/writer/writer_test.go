@@ -0,0 +1,83 @@
+package writer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	logs := base.SliceMonoid[string]()
+
+	withdraw := func(balance, amount int) Writer[[]string, int] {
+		if amount > balance {
+			return New(balance, []string{fmt.Sprintf("rejected withdrawal of %d", amount)})
+		}
+		return New(balance-amount, []string{fmt.Sprintf("withdrew %d", amount)})
+	}
+
+	t.Run("Bind accumulates audit logs in order", func(t *testing.T) {
+		w := Bind(logs, withdraw(100, 30), func(b int) Writer[[]string, int] {
+			return withdraw(b, 100)
+		})
+		balance, audit := w.Run()
+		assert.Equal(t, 70, balance)
+		assert.Equal(t, []string{"withdrew 30", "rejected withdrawal of 100"}, audit)
+	})
+
+	t.Run("Of, Tell and Then", func(t *testing.T) {
+		assert.Empty(t, Of(logs, 1).Output())
+
+		w := Then(logs, Tell([]string{"start"}), Of(logs, 5))
+		assert.Equal(t, 5, w.Value())
+		assert.Equal(t, []string{"start"}, w.Output())
+	})
+
+	t.Run("Map keeps the output", func(t *testing.T) {
+		w := Map(New(2, []string{"x"}), func(x int) string { return fmt.Sprint(x * 2) })
+		assert.Equal(t, "4", w.Value())
+		assert.Equal(t, []string{"x"}, w.Output())
+	})
+
+	t.Run("Listen exposes the output", func(t *testing.T) {
+		listened := Listen(New(1, []string{"a"}))
+		assert.Equal(t, []string{"a"}, listened.Value().Second)
+		assert.Equal(t, 1, listened.Value().First)
+	})
+
+	t.Run("Censor rewrites the output", func(t *testing.T) {
+		redact := func(lines []string) []string {
+			out := make([]string, len(lines))
+			for i, l := range lines {
+				out[i] = strings.ReplaceAll(l, "secret", "***")
+			}
+			return out
+		}
+		w := Censor(New(0, []string{"token=secret"}), redact)
+		assert.Equal(t, []string{"token=***"}, w.Output())
+	})
+
+	t.Run("metric counters", func(t *testing.T) {
+		metrics := base.MapMonoid[string](base.SumMonoid[int]())
+		process := func(item string) Writer[map[string]int, int] {
+			if item == "" {
+				return New(0, map[string]int{"skipped": 1})
+			}
+			return New(len(item), map[string]int{"processed": 1, "bytes": len(item)})
+		}
+
+		w := Traverse(metrics, []string{"ab", "", "cde"}, process)
+		sizes, counters := w.Run()
+		assert.Equal(t, []int{2, 0, 3}, sizes)
+		assert.Equal(t, map[string]int{"processed": 2, "skipped": 1, "bytes": 5}, counters)
+	})
+
+	t.Run("string output", func(t *testing.T) {
+		text := base.StringMonoid()
+		w := Then(text, Tell("a;"), Then(text, Tell("b;"), Of(text, true)))
+		assert.Equal(t, "a;b;", w.Output())
+	})
+}
@@ -0,0 +1,87 @@
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Policy decides, after a failed attempt, whether to try again and how long
+// to wait first. Policies are combined with All.
+type Policy[E any] func(a Attempt[E]) (delay time.Duration, retry bool)
+
+// Constant retries after a fixed delay
+func Constant[E any](d time.Duration) Policy[E] {
+	return func(Attempt[E]) (time.Duration, bool) {
+		return d, true
+	}
+}
+
+// Exponential retries after base, 2*base, 4*base, ..., capped at max (no cap if max <= 0)
+func Exponential[E any](base, max time.Duration) Policy[E] {
+	return func(a Attempt[E]) (time.Duration, bool) {
+		d := base
+		for i := 1; i < a.Number && (max <= 0 || d < max); i++ {
+			if d > math.MaxInt64/2 {
+				d = math.MaxInt64
+				break
+			}
+			d *= 2
+		}
+		if max > 0 && d > max {
+			d = max
+		}
+		return d, true
+	}
+}
+
+// Jitter randomises the delays of p, picking each uniformly from
+// [delay*(1-fraction), delay]. rnd returns values in [0, 1); nil uses math/rand/v2.
+func Jitter[E any](p Policy[E], fraction float64, rnd func() float64) Policy[E] {
+	if rnd == nil {
+		rnd = rand.Float64
+	}
+	return func(a Attempt[E]) (time.Duration, bool) {
+		d, ok := p(a)
+		if !ok {
+			return 0, false
+		}
+		return d - time.Duration(float64(d)*fraction*rnd()), true
+	}
+}
+
+// MaxAttempts stops once n attempts have been made in total
+func MaxAttempts[E any](n int) Policy[E] {
+	return func(a Attempt[E]) (time.Duration, bool) {
+		return 0, a.Number < n
+	}
+}
+
+// MaxElapsed stops once d has passed since the first attempt started
+func MaxElapsed[E any](d time.Duration) Policy[E] {
+	return func(a Attempt[E]) (time.Duration, bool) {
+		return 0, a.Elapsed < d
+	}
+}
+
+// If retries only errors for which pred returns true
+func If[E any](pred func(E) bool) Policy[E] {
+	return func(a Attempt[E]) (time.Duration, bool) {
+		return 0, pred(a.Err)
+	}
+}
+
+// All retries only if every policy agrees, waiting for the longest of their delays
+func All[E any](policies ...Policy[E]) Policy[E] {
+	return func(a Attempt[E]) (time.Duration, bool) {
+		var longest time.Duration
+		for _, p := range policies {
+			d, ok := p(a)
+			if !ok {
+				return 0, false
+			}
+			longest = max(longest, d)
+		}
+		return longest, true
+	}
+}
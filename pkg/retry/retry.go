@@ -0,0 +1,91 @@
+// Package retry re-runs Either-returning actions on Left according to
+// composable policies, recording the history of every attempt.
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+)
+
+// Attempt describes one failed call of the action
+type Attempt[E any] struct {
+	Number  int           // 1 for the first call
+	Err     E             // the Left value returned
+	Start   time.Time     // when the call started
+	Elapsed time.Duration // time since the first call started, measured when this call returned
+	Delay   time.Duration // wait before the next call; zero if there was none
+}
+
+// Report is the history of a Do call
+type Report[E any] struct {
+	Attempts []Attempt[E] // failed attempts, in order
+	Err      error        // the context error if retrying stopped on cancellation
+}
+
+// Clock abstracts time so that policies can be tested without sleeping
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is the Clock backed by package time
+var SystemClock Clock = systemClock{}
+
+type config struct {
+	clock Clock
+}
+
+// Option configures Do
+type Option func(*config)
+
+// WithClock replaces SystemClock
+func WithClock(c Clock) Option {
+	return func(cfg *config) {
+		cfg.clock = c
+	}
+}
+
+// Do calls action until it returns a Right, the policy declines to retry,
+// or the context is done. It returns the last result together with the
+// history of failed attempts.
+func Do[E any, A any](ctx context.Context, policy Policy[E], action func(context.Context) either.Either[E, A], opts ...Option) (either.Either[E, A], Report[E]) {
+	cfg := config{clock: SystemClock}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var report Report[E]
+	first := cfg.clock.Now()
+	for n := 1; ; n++ {
+		start := cfg.clock.Now()
+		result := action(ctx)
+		err, failed := result.LeftValue()
+		if !failed {
+			return result, report
+		}
+
+		attempt := Attempt[E]{Number: n, Err: err, Start: start, Elapsed: cfg.clock.Now().Sub(first)}
+		delay, again := policy(attempt)
+		if again {
+			attempt.Delay = delay
+		}
+		report.Attempts = append(report.Attempts, attempt)
+		if !again {
+			return result, report
+		}
+
+		select {
+		case <-cfg.clock.After(delay):
+		case <-ctx.Done():
+			report.Err = ctx.Err()
+			return result, report
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock advances instantly whenever it is asked to wait
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+var errTemporary = errors.New("temporary")
+
+// failing returns an action that fails n times before succeeding
+func failing(n int, err error) (func(context.Context) either.Either[error, string], *int) {
+	calls := 0
+	return func(context.Context) either.Either[error, string] {
+		calls++
+		if calls <= n {
+			return either.Left[error, string](err)
+		}
+		return either.Right[error]("ok")
+	}, &calls
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries until success", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		action, calls := failing(2, errTemporary)
+
+		result, report := Do(ctx, Constant[error](time.Second), action, WithClock(clock))
+		assert.Equal(t, "ok", result.GetRight())
+		assert.Equal(t, 3, *calls)
+		require.Len(t, report.Attempts, 2)
+		assert.Equal(t, 1, report.Attempts[0].Number)
+		assert.Equal(t, time.Second, report.Attempts[0].Delay)
+		assert.Equal(t, time.Second, report.Attempts[1].Elapsed)
+		assert.Equal(t, []time.Duration{time.Second, time.Second}, clock.sleeps)
+	})
+
+	t.Run("MaxAttempts stops with the last Left", func(t *testing.T) {
+		clock := &fakeClock{}
+		action, calls := failing(10, errTemporary)
+
+		result, report := Do(ctx, All(Constant[error](time.Millisecond), MaxAttempts[error](3)), action, WithClock(clock))
+		assert.Same(t, errTemporary, result.GetLeft())
+		assert.Equal(t, 3, *calls)
+		require.Len(t, report.Attempts, 3)
+		assert.Zero(t, report.Attempts[2].Delay)
+		assert.Len(t, clock.sleeps, 2)
+	})
+
+	t.Run("exponential backoff is capped", func(t *testing.T) {
+		clock := &fakeClock{}
+		action, _ := failing(6, errTemporary)
+
+		Do(ctx, Exponential[error](100*time.Millisecond, time.Second), action, WithClock(clock))
+		assert.Equal(t, []time.Duration{
+			100 * time.Millisecond,
+			200 * time.Millisecond,
+			400 * time.Millisecond,
+			800 * time.Millisecond,
+			time.Second,
+			time.Second,
+		}, clock.sleeps)
+	})
+
+	t.Run("MaxElapsed", func(t *testing.T) {
+		clock := &fakeClock{}
+		action, calls := failing(100, errTemporary)
+
+		_, report := Do(ctx, All(Constant[error](time.Second), MaxElapsed[error](3*time.Second)), action, WithClock(clock))
+		assert.Equal(t, 4, *calls)
+		assert.Equal(t, 3*time.Second, report.Attempts[3].Elapsed)
+	})
+
+	t.Run("If only retries matching errors", func(t *testing.T) {
+		permanent := errors.New("permanent")
+		action, calls := failing(5, permanent)
+
+		result, _ := Do(ctx, All(Constant[error](0), If(func(err error) bool {
+			return errors.Is(err, errTemporary)
+		})), action, WithClock(&fakeClock{}))
+		assert.Same(t, permanent, result.GetLeft())
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("cancellation while waiting", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		calls := 0
+		action := func(context.Context) either.Either[error, string] {
+			calls++
+			cancel()
+			return either.Left[error, string](errTemporary)
+		}
+
+		result, report := Do(cctx, Constant[error](time.Hour), action)
+		assert.True(t, result.IsLeft())
+		assert.Equal(t, 1, calls)
+		assert.ErrorIs(t, report.Err, context.Canceled)
+	})
+}
+
+func TestJitter(t *testing.T) {
+	a := Attempt[error]{Number: 1}
+
+	d, ok := Jitter(Constant[error](time.Second), 0.5, func() float64 { return 0.5 })(a)
+	assert.True(t, ok)
+	assert.Equal(t, 750*time.Millisecond, d)
+
+	for range 100 {
+		d, _ := Jitter(Exponential[error](time.Second, 0), 1, nil)(Attempt[error]{Number: 3})
+		assert.True(t, d > 0 && d <= 4*time.Second, "delay %v out of range", d)
+	}
+
+	_, ok = Jitter(MaxAttempts[error](1), 0.5, nil)(a)
+	assert.False(t, ok)
+}
@@ -0,0 +1,72 @@
+// Package seq provides iterator combinators for streams of Maybe values
+// and (value, error) pairs.
+package seq
+
+import (
+	"iter"
+
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+)
+
+// Compact yields the values of the Just elements and skips the Nothings
+func Compact[T any](s iter.Seq[maybe.Maybe[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for m := range s {
+			for v := range m.All() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileJust yields values until the first Nothing, then stops
+func TakeWhileJust[T any](s iter.Seq[maybe.Maybe[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for m := range s {
+			if m.IsNothing() || !yield(m.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// FirstJust returns the first Just in the stream, consuming no further elements
+func FirstJust[T any](s iter.Seq[maybe.Maybe[T]]) maybe.Maybe[T] {
+	for m := range s {
+		if m.IsJust() {
+			return m
+		}
+	}
+	return maybe.Nothing[T]()
+}
+
+// Results turns a (value, error) stream into a stream of Eithers
+func Results[T any](s iter.Seq2[T, error]) iter.Seq[either.Either[error, T]] {
+	return func(yield func(either.Either[error, T]) bool) {
+		for v, err := range s {
+			e := either.Right[error](v)
+			if err != nil {
+				e = either.Left[error, T](err)
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// CollectResults gathers every value of a (value, error) stream, stopping
+// at and returning the first error
+func CollectResults[T any](s iter.Seq2[T, error]) either.Either[error, []T] {
+	var out []T
+	for v, err := range s {
+		if err != nil {
+			return either.Left[error, []T](err)
+		}
+		out = append(out, v)
+	}
+	return either.Right[error](out)
+}
@@ -0,0 +1,87 @@
+package seq
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+	"github.com/stretchr/testify/assert"
+)
+
+func maybes(xs ...int) iter.Seq[maybe.Maybe[int]] {
+	return func(yield func(maybe.Maybe[int]) bool) {
+		for _, x := range xs {
+			m := maybe.Just(x)
+			if x < 0 {
+				m = maybe.Nothing[int]()
+			}
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+func pairs(errAt int, xs ...int) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for i, x := range xs {
+			var err error
+			if i == errAt {
+				err = errors.New("read failed")
+			}
+			if !yield(x, err) {
+				return
+			}
+		}
+	}
+}
+
+func TestCompact(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, slices.Collect(Compact(maybes(1, -1, 3))))
+
+	for v := range Compact(maybes(1, 2, 3)) {
+		assert.Equal(t, 1, v)
+		break
+	}
+}
+
+func TestTakeWhileJust(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, slices.Collect(TakeWhileJust(maybes(1, 2, -1, 4))))
+	assert.Empty(t, slices.Collect(TakeWhileJust(maybes(-1, 2))))
+}
+
+func TestFirstJust(t *testing.T) {
+	pulled := 0
+	counted := func(yield func(maybe.Maybe[int]) bool) {
+		for m := range maybes(-1, 5, 6) {
+			pulled++
+			if !yield(m) {
+				return
+			}
+		}
+	}
+
+	assert.Equal(t, 5, FirstJust(counted).Get())
+	assert.Equal(t, 2, pulled)
+	assert.True(t, FirstJust(maybes(-1, -2)).IsNothing())
+}
+
+func TestResults(t *testing.T) {
+	var rights, lefts int
+	for e := range Results(pairs(1, 10, 20, 30)) {
+		if e.IsLeft() {
+			lefts++
+		} else {
+			rights++
+		}
+	}
+	assert.Equal(t, 2, rights)
+	assert.Equal(t, 1, lefts)
+}
+
+func TestCollectResults(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, CollectResults(pairs(-1, 1, 2, 3)).GetRight())
+	assert.EqualError(t, CollectResults(pairs(1, 1, 2, 3)).GetLeft(), "read failed")
+}
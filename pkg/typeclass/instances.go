@@ -0,0 +1,104 @@
+package typeclass
+
+import (
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/data/list"
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+	"github.com/kpse/go-cat/pkg/monad/reader"
+	"github.com/kpse/go-cat/pkg/monad/state"
+	"github.com/kpse/go-cat/pkg/monad/validated"
+)
+
+// MaybeMonad is the Monad instance for maybe.Maybe
+func MaybeMonad[A any, B any]() Monad[maybe.Maybe[A], A, maybe.Maybe[B], B, maybe.Maybe[func(A) B]] {
+	return NewMonad(maybe.Map[A, B], maybe.Just[B], maybe.Ap[A, B], maybe.FlatMap[A, B])
+}
+
+// EitherMonad is the Monad instance for either.Either with a fixed Left type E
+func EitherMonad[E any, A any, B any]() Monad[either.Either[E, A], A, either.Either[E, B], B, either.Either[E, func(A) B]] {
+	return NewMonad(either.Map[E, A, B], either.Right[E, B], either.Ap[E, A, B], either.Bind[E, A, B])
+}
+
+// StateMonad is the Monad instance for state.State with a fixed state type S
+func StateMonad[S any, A any, B any]() Monad[state.State[S, A], A, state.State[S, B], B, state.State[S, func(A) B]] {
+	ap := func(sf state.State[S, func(A) B], sa state.State[S, A]) state.State[S, B] {
+		return state.Bind(sf, func(f func(A) B) state.State[S, B] { return state.Map(sa, f) })
+	}
+	return NewMonad(state.Map[S, A, B], state.Of[S, B], ap, state.Bind[S, A, B])
+}
+
+// ReaderMonad is the Monad instance for reader.Reader with a fixed environment type R
+func ReaderMonad[R any, A any, B any]() Monad[reader.Reader[R, A], A, reader.Reader[R, B], B, reader.Reader[R, func(A) B]] {
+	ap := func(rf reader.Reader[R, func(A) B], ra reader.Reader[R, A]) reader.Reader[R, B] {
+		return func(r R) B { return rf(r)(ra(r)) }
+	}
+	return NewMonad(reader.Map[R, A, B], reader.Of[R, B], ap, reader.Bind[R, A, B])
+}
+
+// ListMonad is the Monad instance for list.List, modelling nondeterminism
+func ListMonad[A any, B any]() Monad[list.List[A], A, list.List[B], B, list.List[func(A) B]] {
+	ap := func(fs list.List[func(A) B], xs list.List[A]) list.List[B] {
+		return list.FlatMap(fs, func(f func(A) B) list.List[B] { return list.Map(xs, f) })
+	}
+	return NewMonad(list.Map[A, B], func(b B) list.List[B] { return list.Of(b) }, ap, list.FlatMap[A, B])
+}
+
+// MaybeTraversal is the Traversal instance for maybe.Maybe
+func MaybeTraversal[B any]() Traversal[maybe.Maybe[B], B, maybe.Maybe[list.List[B]], maybe.Maybe[[]B], maybe.Maybe[struct{}]] {
+	return NewTraversal(
+		MaybeMonad[list.List[B], list.List[B]](),
+		MaybeMonad[B, list.List[B]](),
+		MaybeMonad[list.List[B], []B](),
+		MaybeMonad[list.List[B], struct{}](),
+	)
+}
+
+// EitherTraversal is the Traversal instance for either.Either with a fixed Left type E
+func EitherTraversal[E any, B any]() Traversal[either.Either[E, B], B, either.Either[E, list.List[B]], either.Either[E, []B], either.Either[E, struct{}]] {
+	return NewTraversal(
+		EitherMonad[E, list.List[B], list.List[B]](),
+		EitherMonad[E, B, list.List[B]](),
+		EitherMonad[E, list.List[B], []B](),
+		EitherMonad[E, list.List[B], struct{}](),
+	)
+}
+
+// StateTraversal is the Traversal instance for state.State with a fixed state type S
+func StateTraversal[S any, B any]() Traversal[state.State[S, B], B, state.State[S, list.List[B]], state.State[S, []B], state.State[S, struct{}]] {
+	return NewTraversal(
+		StateMonad[S, list.List[B], list.List[B]](),
+		StateMonad[S, B, list.List[B]](),
+		StateMonad[S, list.List[B], []B](),
+		StateMonad[S, list.List[B], struct{}](),
+	)
+}
+
+// ReaderTraversal is the Traversal instance for reader.Reader with a fixed environment type R
+func ReaderTraversal[R any, B any]() Traversal[reader.Reader[R, B], B, reader.Reader[R, list.List[B]], reader.Reader[R, []B], reader.Reader[R, struct{}]] {
+	return NewTraversal(
+		ReaderMonad[R, list.List[B], list.List[B]](),
+		ReaderMonad[R, B, list.List[B]](),
+		ReaderMonad[R, list.List[B], []B](),
+		ReaderMonad[R, list.List[B], struct{}](),
+	)
+}
+
+// ListTraversal is the Traversal instance for list.List
+func ListTraversal[B any]() Traversal[list.List[B], B, list.List[list.List[B]], list.List[[]B], list.List[struct{}]] {
+	return NewTraversal(
+		ListMonad[list.List[B], list.List[B]](),
+		ListMonad[B, list.List[B]](),
+		ListMonad[list.List[B], []B](),
+		ListMonad[list.List[B], struct{}](),
+	)
+}
+
+// ValidatedApplicative is the Applicative instance for validated.Validated.
+// It has no Monad instance: Ap combines the errors of both sides with s.
+func ValidatedApplicative[E any, A any, B any](s base.Semigroup[E]) Applicative[validated.Validated[E, A], A, validated.Validated[E, B], B, validated.Validated[E, func(A) B]] {
+	ap := func(vf validated.Validated[E, func(A) B], va validated.Validated[E, A]) validated.Validated[E, B] {
+		return validated.Ap(s, vf, va)
+	}
+	return NewApplicative(validated.Map[E, A, B], validated.Valid[E, B], ap)
+}
@@ -0,0 +1,142 @@
+// Package typeclass encodes Functor, Applicative and Monad as dictionaries
+// of functions so that generic algorithms can be written once for every
+// container in this module.
+//
+// Go has no higher-kinded types, so F[A] and F[B] are separate type
+// parameters: FA stands for F[A] and FB for F[B]. An instance such as
+// MaybeMonad[A, B]() fixes all four. Traverse, Sequence and ForM take a
+// Traversal instance such as MaybeTraversal[B](), which bundles the Monad
+// dictionaries they need so that they can run as loops.
+package typeclass
+
+import "github.com/kpse/go-cat/pkg/data/list"
+
+// Functor maps the contents of FA with a function from A to B, producing FB
+type Functor[FA any, A any, FB any, B any] struct {
+	Map func(fa FA, f func(A) B) FB
+}
+
+// Applicative is a Functor that can also lift a plain value into FB and
+// apply functions held inside the container. FF stands for F[func(A) B].
+// Applicatives that are not monads, such as validated.Validated, combine
+// independent failures in Ap instead of stopping at the first.
+type Applicative[FA any, A any, FB any, B any, FF any] struct {
+	Functor[FA, A, FB, B]
+	Pure func(b B) FB
+	Ap   func(ff FF, fa FA) FB
+}
+
+// Monad is an Applicative that can sequence dependent computations
+type Monad[FA any, A any, FB any, B any, FF any] struct {
+	Applicative[FA, A, FB, B, FF]
+	Bind func(fa FA, f func(A) FB) FB
+}
+
+// NewApplicative builds an Applicative dictionary from its three operations
+func NewApplicative[FA any, A any, FB any, B any, FF any](
+	mapFn func(FA, func(A) B) FB,
+	pure func(B) FB,
+	ap func(FF, FA) FB,
+) Applicative[FA, A, FB, B, FF] {
+	return Applicative[FA, A, FB, B, FF]{
+		Functor: Functor[FA, A, FB, B]{Map: mapFn},
+		Pure:    pure,
+		Ap:      ap,
+	}
+}
+
+// NewMonad builds a Monad dictionary from its four operations
+func NewMonad[FA any, A any, FB any, B any, FF any](
+	mapFn func(FA, func(A) B) FB,
+	pure func(B) FB,
+	ap func(FF, FA) FB,
+	bind func(FA, func(A) FB) FB,
+) Monad[FA, A, FB, B, FF] {
+	return Monad[FA, A, FB, B, FF]{
+		Applicative: NewApplicative(mapFn, pure, ap),
+		Bind:        bind,
+	}
+}
+
+// Traversal is the dictionary Traverse, Sequence and ForM need for a
+// container F. Results are accumulated in FL, which stands for
+// F[list.List[B]], so that each step is a left fold whose Bind goes from
+// FL to FL: the call stack stays flat for monads whose Bind runs eagerly,
+// such as Maybe and Either. FBs stands for F[[]B] and FU for F[struct{}].
+type Traversal[FB any, B any, FL any, FBs any, FU any] struct {
+	Pure    func(l list.List[B]) FL
+	Bind    func(fl FL, f func(list.List[B]) FL) FL
+	Push    func(fb FB, f func(B) list.List[B]) FL
+	Collect func(fl FL, f func(list.List[B]) []B) FBs
+	Discard func(fl FL, f func(list.List[B]) struct{}) FU
+}
+
+// NewTraversal builds a Traversal from Monad instances of the same
+// container at the four type pairings it needs
+func NewTraversal[FB any, B any, FL any, FBs any, FU any, F1 any, F2 any, F3 any, F4 any](
+	acc Monad[FL, list.List[B], FL, list.List[B], F1],
+	elem Monad[FB, B, FL, list.List[B], F2],
+	collect Monad[FL, list.List[B], FBs, []B, F3],
+	discard Monad[FL, list.List[B], FU, struct{}, F4],
+) Traversal[FB, B, FL, FBs, FU] {
+	return Traversal[FB, B, FL, FBs, FU]{
+		Pure:    acc.Pure,
+		Bind:    acc.Bind,
+		Push:    elem.Map,
+		Collect: collect.Map,
+		Discard: discard.Map,
+	}
+}
+
+// Traverse applies f to every element in order and collects the results
+// inside the container
+func Traverse[A any, B any, FB any, FL any, FBs any, FU any](t Traversal[FB, B, FL, FBs, FU], xs []A, f func(A) FB) FBs {
+	// Results are kept newest first in a persistent list, so each step is
+	// O(1) and branches of a nondeterministic monad share their prefix
+	acc := t.Pure(list.Empty[B]())
+	for _, x := range xs {
+		acc = t.Bind(acc, func(l list.List[B]) FL {
+			return t.Push(f(x), l.Cons)
+		})
+	}
+	return t.Collect(acc, reversed[B])
+}
+
+// reversed copies a newest-first accumulator into a fresh slice in order
+func reversed[B any](acc list.List[B]) []B {
+	out := make([]B, acc.Len())
+	i := len(out)
+	for b := range acc.All() {
+		i--
+		out[i] = b
+	}
+	return out
+}
+
+// Sequence turns a slice of computations into one computation of a slice
+func Sequence[B any, FB any, FL any, FBs any, FU any](t Traversal[FB, B, FL, FBs, FU], fbs []FB) FBs {
+	return Traverse(t, fbs, func(fb FB) FB { return fb })
+}
+
+// ForM runs f for every element in order for its effects only, discarding
+// the results
+func ForM[A any, B any, FB any, FL any, FBs any, FU any](t Traversal[FB, B, FL, FBs, FU], xs []A, f func(A) FB) FU {
+	empty := list.Empty[B]()
+	keepEmpty := func(B) list.List[B] { return empty }
+	acc := t.Pure(empty)
+	for _, x := range xs {
+		acc = t.Bind(acc, func(list.List[B]) FL {
+			return t.Push(f(x), keepEmpty)
+		})
+	}
+	return t.Discard(acc, func(list.List[B]) struct{} { return struct{}{} })
+}
+
+// FoldM folds over xs with a step that runs inside the monad
+func FoldM[A any, B any, FB any, FF any](m Monad[FB, B, FB, B, FF], initial B, xs []A, f func(B, A) FB) FB {
+	acc := m.Pure(initial)
+	for _, x := range xs {
+		acc = m.Bind(acc, func(b B) FB { return f(b, x) })
+	}
+	return acc
+}
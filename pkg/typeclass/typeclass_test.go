@@ -0,0 +1,180 @@
+package typeclass
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"testing"
+
+	"github.com/kpse/go-cat/pkg/base"
+	"github.com/kpse/go-cat/pkg/data/list"
+	"github.com/kpse/go-cat/pkg/monad/either"
+	"github.com/kpse/go-cat/pkg/monad/maybe"
+	"github.com/kpse/go-cat/pkg/monad/reader"
+	"github.com/kpse/go-cat/pkg/monad/state"
+	"github.com/kpse/go-cat/pkg/monad/validated"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	t.Run("Maybe", func(t *testing.T) {
+		parse := func(s string) maybe.Maybe[int] {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return maybe.Nothing[int]()
+			}
+			return maybe.Just(n)
+		}
+		m := MaybeTraversal[int]()
+
+		assert.Equal(t, []int{1, 2, 3}, Traverse(m, []string{"1", "2", "3"}, parse).Get())
+		assert.True(t, Traverse(m, []string{"1", "x"}, parse).IsNothing())
+		assert.Equal(t, []int{}, Traverse(m, []string{}, parse).Get())
+	})
+
+	t.Run("Either", func(t *testing.T) {
+		m := EitherTraversal[string, int]()
+		es := []either.Either[string, int]{either.Right[string](1), either.Left[string, int]("bad"), either.Left[string, int]("worse")}
+		assert.Equal(t, "bad", Sequence(m, es).GetLeft())
+	})
+
+	t.Run("State", func(t *testing.T) {
+		allocate := func(name string) state.State[int, string] {
+			return func(next int) (string, int) { return fmt.Sprintf("%s#%d", name, next), next + 1 }
+		}
+		ids, next := Traverse(StateTraversal[int, string](), []string{"a", "b"}, allocate).Run(10)
+		assert.Equal(t, []string{"a#10", "b#11"}, ids)
+		assert.Equal(t, 12, next)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		scaled := func(x int) reader.Reader[int, int] {
+			return reader.Asks(func(factor int) int { return x * factor })
+		}
+		assert.Equal(t, []int{3, 6}, Traverse(ReaderTraversal[int, int](), []int{1, 2}, scaled).Run(3))
+	})
+
+	t.Run("List gives every combination", func(t *testing.T) {
+		choices := func(x int) list.List[int] { return list.Of(x, -x) }
+		combos := Traverse(ListTraversal[int](), []int{1, 2}, choices).ToSlice()
+		assert.Equal(t, [][]int{{1, 2}, {1, -2}, {-1, 2}, {-1, -2}}, combos)
+	})
+}
+
+// TestTraverseLargeInput checks that Traverse and ForM loop rather than
+// recurse. The stack limit is lowered so that recursing once per element
+// overflows at once instead of after using a gigabyte.
+func TestTraverseLargeInput(t *testing.T) {
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	const n = 3_000_000
+	xs := make([]int, n)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	out := Traverse(MaybeTraversal[int](), xs, maybe.Just[int]).Get()
+	assert.Len(t, out, n)
+	assert.Equal(t, n-1, out[n-1])
+
+	failed := Traverse(EitherTraversal[string, int](), xs, func(x int) either.Either[string, int] {
+		if x == n-1 {
+			return either.Left[string, int]("last")
+		}
+		return either.Right[string](x)
+	})
+	assert.Equal(t, "last", failed.GetLeft())
+
+	count := 0
+	done := ForM(MaybeTraversal[int](), xs, func(x int) maybe.Maybe[int] { count++; return maybe.Just(x) })
+	assert.True(t, done.IsJust())
+	assert.Equal(t, n, count)
+}
+
+func TestAp(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+
+	t.Run("Maybe", func(t *testing.T) {
+		m := MaybeMonad[int, int]()
+		assert.Equal(t, maybe.Just(6), m.Ap(maybe.Just(double), maybe.Just(3)))
+		assert.True(t, m.Ap(maybe.Nothing[func(int) int](), maybe.Just(3)).IsNothing())
+	})
+
+	t.Run("List", func(t *testing.T) {
+		m := ListMonad[int, int]()
+		fs := list.Of(double, func(x int) int { return -x })
+		assert.Equal(t, []int{2, 4, -1, -2}, m.Ap(fs, list.Of(1, 2)).ToSlice())
+	})
+
+	t.Run("State", func(t *testing.T) {
+		m := StateMonad[int, int, int]()
+		next := state.State[int, int](func(s int) (int, int) { return s, s + 1 })
+		sf := state.Map(next, func(a int) func(int) int { return func(b int) int { return a*10 + b } })
+		v, s := m.Ap(sf, next).Run(1)
+		assert.Equal(t, 12, v)
+		assert.Equal(t, 3, s)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		m := ReaderMonad[int, int, int]()
+		rf := reader.Asks(func(env int) func(int) int { return func(x int) int { return x + env } })
+		assert.Equal(t, 10, m.Ap(rf, reader.Ask[int]()).Run(5))
+	})
+
+	t.Run("Validated accumulates both sides", func(t *testing.T) {
+		m := ValidatedApplicative[[]string, int, int](base.SliceMonoid[string]())
+		vf := validated.Invalid[[]string, func(int) int]([]string{"no function"})
+		va := validated.Invalid[[]string, int]([]string{"no value"})
+		errs, _ := m.Ap(vf, va).Errors()
+		assert.Equal(t, []string{"no function", "no value"}, errs)
+
+		v, ok := m.Ap(validated.Valid[[]string](double), validated.Valid[[]string](3)).Value()
+		assert.True(t, ok)
+		assert.Equal(t, 6, v)
+	})
+}
+
+func TestForM(t *testing.T) {
+	count := func(x int) state.State[int, int] {
+		return func(total int) (int, int) { return x, total + x }
+	}
+	total := ForM(StateTraversal[int, int](), []int{1, 2, 3}, count).Exec(0)
+	assert.Equal(t, 6, total)
+
+	check := func(x int) maybe.Maybe[int] { return maybe.Filter(maybe.Just(x), func(x int) bool { return x > 0 }) }
+	assert.True(t, ForM(MaybeTraversal[int](), []int{1, 2}, check).IsJust())
+	assert.True(t, ForM(MaybeTraversal[int](), []int{1, -2}, check).IsNothing())
+}
+
+func TestFoldM(t *testing.T) {
+	safeAdd := func(acc int, x int) either.Either[string, int] {
+		if acc+x > 10 {
+			return either.Left[string, int](fmt.Sprintf("overflow at %d", x))
+		}
+		return either.Right[string](acc + x)
+	}
+	m := EitherMonad[string, int, int]()
+
+	assert.Equal(t, 6, FoldM(m, 0, []int{1, 2, 3}, safeAdd).GetRight())
+	assert.Equal(t, "overflow at 8", FoldM(m, 0, []int{1, 2, 8}, safeAdd).GetLeft())
+}
+
+func TestFunctorLaws(t *testing.T) {
+	m := MaybeMonad[int, int]()
+	id := func(x int) int { return x }
+	f := func(x int) int { return x + 1 }
+	g := func(x int) int { return x * 2 }
+
+	assert.Equal(t, maybe.Just(3), m.Map(maybe.Just(3), id))
+	assert.Equal(t, m.Map(maybe.Just(3), func(x int) int { return g(f(x)) }), m.Map(m.Map(maybe.Just(3), f), g))
+	assert.Equal(t, m.Pure(4), m.Bind(maybe.Just(3), func(x int) maybe.Maybe[int] { return m.Pure(f(x)) }))
+}
+
+func BenchmarkTraverse(b *testing.B) {
+	xs := make([]int, 10_000)
+	m := MaybeTraversal[int]()
+	b.ResetTimer()
+	for range b.N {
+		Traverse(m, xs, maybe.Just[int])
+	}
+}